@@ -127,6 +127,20 @@ func main() {
 	pixelsPerUnit := flag.Int("pixelsPerUnit", 1, "Amount of pixels per unit. Set to '> 1' to have smaller price granularity than 1 wei / pixel")
 	// Interval to poll for blocks
 	blockPollingInterval := flag.Int("blockPollingInterval", 5, "Interval in seconds at which different blockchain event services poll for blocks")
+	// Orchestrator ticket redemption
+	redemptionFailureThreshold := flag.Int("redemptionFailureThreshold", 0, "Number of consecutive ticket redemption failures after which ticket redemption is paused. If 0, ticket redemption is never paused")
+	redemptionCooldown := flag.Int("redemptionCooldown", 300, "Interval in seconds for which ticket redemption is paused after reaching -redemptionFailureThreshold")
+	maxRedemptionAttempts := flag.Int("maxRedemptionAttempts", 1, "Number of times submitting a winning ticket for redemption is attempted before the ticket is dropped. If 1, a failed redemption is not retried")
+	redemptionRetryBackoff := flag.Int("redemptionRetryBackoff", 5, "Number of blocks to wait before retrying a failed ticket redemption. The number of blocks doubles after each failed attempt")
+	maxRedemptionGasPrice := flag.Int("maxRedemptionGasPrice", 0, "Maximum gas price (in wei) at which winning tickets are redeemed. Tickets remain queued while the gas price is higher. If 0, tickets are redeemed at any gas price")
+	redemptionShadowMode := flag.Bool("redemptionShadowMode", false, "Set to true to run in shadow mode: winning tickets are validated and logged but never redeemed on-chain. Only use this to test changes alongside a node that redeems tickets")
+	maxFaceValue := flag.String("maxFaceValue", "0", "Maximum face value (in wei) of PM tickets. Must not be less than -ticketEV. If 0, the face value is not capped")
+	minSenderReserve := flag.String("minSenderReserve", "0", "Minimum amount (in wei) of a sender's reserve allocated to the orchestrator and not pending redemption required to accept tickets from the sender. If 0, there is no minimum")
+	senderAllowlist := flag.String("senderAllowlist", "", "Comma-separated list of ETH addresses of the only senders to accept tickets from. If empty, tickets are accepted from all senders not in -senderDenylist")
+	senderDenylist := flag.String("senderDenylist", "", "Comma-separated list of ETH addresses of senders to reject tickets from")
+	persistTicketQueue := flag.Bool("persistTicketQueue", false, "Set to true to persist winning tickets queued for redemption in the database so that they are redeemed after a restart")
+	storeDeadLetteredTickets := flag.Bool("storeDeadLetteredTickets", false, "Set to true to store winning tickets that could not be redeemed in the database")
+	revalidateQueuedTickets := flag.Bool("revalidateQueuedTickets", false, "Set to true to check that queued winning tickets have not expired or already been redeemed immediately before redeeming them")
 	// Metrics & logging:
 	monitor := flag.Bool("monitor", false, "Set to true to send performance metrics")
 	version := flag.Bool("version", false, "Print out the version")
@@ -485,6 +499,40 @@ func main() {
 				return
 			}
 
+			maxFV, _ := new(big.Int).SetString(*maxFaceValue, 10)
+			if maxFV == nil {
+				glog.Errorf("-maxFaceValue must be a valid integer, but %v provided. Restart the node with a different valid value for -maxFaceValue", *maxFaceValue)
+				return
+			}
+
+			if maxFV.Cmp(big.NewInt(0)) != 0 && maxFV.Cmp(ev) < 0 {
+				glog.Errorf("-maxFaceValue must be 0 or not less than -ticketEV, but %v provided. Restart the node with a different valid value for -maxFaceValue", *maxFaceValue)
+				return
+			}
+
+			minReserve, _ := new(big.Int).SetString(*minSenderReserve, 10)
+			if minReserve == nil {
+				glog.Errorf("-minSenderReserve must be a valid integer, but %v provided. Restart the node with a different valid value for -minSenderReserve", *minSenderReserve)
+				return
+			}
+
+			if minReserve.Cmp(big.NewInt(0)) < 0 {
+				glog.Errorf("-minSenderReserve must not be negative, but %v provided. Restart the node with a different valid value for -minSenderReserve", *minSenderReserve)
+				return
+			}
+
+			allowlist, err := common.ParseAddresses(*senderAllowlist)
+			if err != nil {
+				glog.Errorf("-senderAllowlist must be a comma-separated list of ETH addresses: %v. Restart the node with a different valid value for -senderAllowlist", err)
+				return
+			}
+
+			denylist, err := common.ParseAddresses(*senderDenylist)
+			if err != nil {
+				glog.Errorf("-senderDenylist must be a comma-separated list of ETH addresses: %v. Restart the node with a different valid value for -senderDenylist", err)
+				return
+			}
+
 			orchSetupCtx, cancel := context.WithCancel(ctx)
 			defer cancel()
 
@@ -504,22 +552,73 @@ func main() {
 			validator := pm.NewValidator(sigVerifier, timeWatcher)
 			gpm := eth.NewGasPriceMonitor(backend, blockPollingTime)
 			// Start gas price monitor
-			_, err := gpm.Start(ctx)
+			_, err = gpm.Start(ctx)
 			if err != nil {
 				glog.Errorf("error starting gas price monitor: %v", err)
 				return
 			}
 			defer gpm.Stop()
 
-			sm := pm.NewSenderMonitor(n.Eth.Account().Address, n.Eth, senderWatcher, timeWatcher, cleanupInterval, smTTL)
+			var sm pm.SenderMonitor
+			if *persistTicketQueue {
+				newQueue := func() pm.TicketQueue { return pm.NewPersistentTicketQueue(n.Database) }
+				sm = pm.NewSenderMonitorWithTicketQueue(n.Eth.Account().Address, n.Eth, senderWatcher, timeWatcher, cleanupInterval, smTTL, newQueue)
+			} else {
+				sm = pm.NewSenderMonitor(n.Eth.Account().Address, n.Eth, senderWatcher, timeWatcher, cleanupInterval, smTTL)
+			}
 			// Start sender monitor
 			sm.Start()
 			defer sm.Stop()
 
+			if *persistTicketQueue {
+				// Queue the tickets that were queued for redemption before the node was restarted
+				if err := pm.RequeueStoredTickets(n.Database, sm); err != nil {
+					glog.Errorf("Error requeueing persisted tickets: %v", err)
+					return
+				}
+			}
+
+			if *redemptionShadowMode {
+				glog.Warning("Running in redemption shadow mode - winning tickets will not be redeemed")
+			}
+
+			var maxGasPrice *big.Int
+			if *maxRedemptionGasPrice > 0 {
+				maxGasPrice = big.NewInt(int64(*maxRedemptionGasPrice))
+			}
+
+			var maxFVCfg *big.Int
+			if maxFV.Cmp(big.NewInt(0)) > 0 {
+				maxFVCfg = maxFV
+			}
+
+			var minReserveCfg *big.Int
+			if minReserve.Cmp(big.NewInt(0)) > 0 {
+				minReserveCfg = minReserve
+			}
+
+			var deadLetterSink pm.DeadLetterSink
+			if *storeDeadLetteredTickets {
+				deadLetterSink = n.Database
+			}
+
 			cfg := pm.TicketParamsConfig{
 				EV:               ev,
 				RedeemGas:        redeemGas,
 				TxCostMultiplier: txCostMultiplier,
+
+				RedemptionFailureThreshold: *redemptionFailureThreshold,
+				RedemptionCooldown:         time.Duration(*redemptionCooldown) * time.Second,
+				MaxRedemptionAttempts:      *maxRedemptionAttempts,
+				RedemptionRetryBackoff:     *redemptionRetryBackoff,
+				DeadLetterSink:             deadLetterSink,
+				MaxRedemptionGasPrice:      maxGasPrice,
+				RevalidateQueuedTickets:    *revalidateQueuedTickets,
+				MaxFaceValue:               maxFVCfg,
+				MinSenderReserve:           minReserveCfg,
+				SenderAllowlist:            allowlist,
+				SenderDenylist:             denylist,
+				ShadowMode:                 *redemptionShadowMode,
 			}
 			n.Recipient, err = pm.NewRecipient(
 				recipientAddr,
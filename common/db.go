@@ -33,6 +33,10 @@ type DB struct {
 	unbondingLocks                   *sql.Stmt
 	withdrawableUnbondingLocks       *sql.Stmt
 	insertWinningTicket              *sql.Stmt
+	insertQueuedTicket               *sql.Stmt
+	deleteQueuedTicket               *sql.Stmt
+	selectQueuedTickets              *sql.Stmt
+	insertDeadLetteredTicket         *sql.Stmt
 	insertMiniHeader                 *sql.Stmt
 	findLatestMiniHeader             *sql.Stmt
 	findAllMiniHeadersSortedByNumber *sql.Stmt
@@ -114,6 +118,41 @@ var schema = `
 
 	CREATE INDEX IF NOT EXISTS idx_winningtickets_sessionid ON winningTickets(sessionID);
 
+	CREATE TABLE IF NOT EXISTS queuedTickets (
+		createdAt STRING DEFAULT CURRENT_TIMESTAMP,
+		ticketHash STRING PRIMARY KEY,
+		sender STRING,
+		recipient STRING,
+		faceValue BLOB,
+		winProb BLOB,
+		senderNonce INTEGER,
+		recipientRandHash STRING,
+		creationRound int64,
+		creationRoundBlockHash STRING,
+		paramsExpirationBlock BLOB,
+		pricePerPixel STRING,
+		sig BLOB,
+		recipientRand BLOB
+	);
+
+	CREATE TABLE IF NOT EXISTS deadLetteredTickets (
+		createdAt STRING DEFAULT CURRENT_TIMESTAMP,
+		ticketHash STRING PRIMARY KEY,
+		sender STRING,
+		recipient STRING,
+		faceValue BLOB,
+		winProb BLOB,
+		senderNonce INTEGER,
+		recipientRandHash STRING,
+		creationRound int64,
+		creationRoundBlockHash STRING,
+		paramsExpirationBlock BLOB,
+		pricePerPixel STRING,
+		sig BLOB,
+		recipientRand BLOB,
+		reason STRING
+	);
+
 	CREATE TABLE IF NOT EXISTS blockheaders (
 		number int64,
 		parent STRING,
@@ -277,6 +316,38 @@ func InitDB(dbPath string) (*DB, error) {
 	}
 	d.insertWinningTicket = stmt
 
+	// Queued tickets prepared statements
+	stmt, err = db.Prepare("INSERT OR IGNORE INTO queuedTickets(ticketHash, sender, recipient, faceValue, winProb, senderNonce, recipientRandHash, creationRound, creationRoundBlockHash, paramsExpirationBlock, pricePerPixel, sig, recipientRand) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		glog.Error("Unable to prepare insertQueuedTicket ", err)
+		d.Close()
+		return nil, err
+	}
+	d.insertQueuedTicket = stmt
+	stmt, err = db.Prepare("DELETE FROM queuedTickets WHERE ticketHash=?")
+	if err != nil {
+		glog.Error("Unable to prepare deleteQueuedTicket ", err)
+		d.Close()
+		return nil, err
+	}
+	d.deleteQueuedTicket = stmt
+	stmt, err = db.Prepare("SELECT sender, recipient, faceValue, winProb, senderNonce, recipientRandHash, creationRound, creationRoundBlockHash, paramsExpirationBlock, pricePerPixel, sig, recipientRand FROM queuedTickets ORDER BY rowid")
+	if err != nil {
+		glog.Error("Unable to prepare selectQueuedTickets ", err)
+		d.Close()
+		return nil, err
+	}
+	d.selectQueuedTickets = stmt
+
+	// Dead-lettered tickets prepared statements
+	stmt, err = db.Prepare("INSERT OR IGNORE INTO deadLetteredTickets(ticketHash, sender, recipient, faceValue, winProb, senderNonce, recipientRandHash, creationRound, creationRoundBlockHash, paramsExpirationBlock, pricePerPixel, sig, recipientRand, reason) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		glog.Error("Unable to prepare insertDeadLetteredTicket ", err)
+		d.Close()
+		return nil, err
+	}
+	d.insertDeadLetteredTicket = stmt
+
 	// Insert block header
 	stmt, err = db.Prepare("INSERT INTO blockheaders(number, parent, hash, logs) VALUES(?, ?, ?, ?)")
 	if err != nil {
@@ -346,6 +417,18 @@ func (db *DB) Close() {
 	if db.insertWinningTicket != nil {
 		db.insertWinningTicket.Close()
 	}
+	if db.insertQueuedTicket != nil {
+		db.insertQueuedTicket.Close()
+	}
+	if db.deleteQueuedTicket != nil {
+		db.deleteQueuedTicket.Close()
+	}
+	if db.selectQueuedTickets != nil {
+		db.selectQueuedTickets.Close()
+	}
+	if db.insertDeadLetteredTicket != nil {
+		db.insertDeadLetteredTicket.Close()
+	}
 	if db.insertMiniHeader != nil {
 		db.insertMiniHeader.Close()
 	}
@@ -658,6 +741,143 @@ func (db *DB) LoadWinningTickets(sessionIDs []string) (tickets []*pm.Ticket, sig
 	return
 }
 
+// StoreQueuedTicket persists a ticket that is queued for redemption
+// Storing a ticket that is already persisted is a no-op
+func (db *DB) StoreQueuedTicket(ticket *pm.SignedTicket) error {
+	values, err := signedTicketValues(ticket)
+	if err != nil {
+		return err
+	}
+	glog.V(DEBUG).Infof("db: Inserting queued ticket from %v, recipientRandHash %v, senderNonce %d", ticket.Sender.Hex(), ticket.RecipientRandHash.Hex(), ticket.SenderNonce)
+
+	_, err = db.insertQueuedTicket.Exec(values...)
+	if err != nil {
+		return errors.Wrapf(err, "failed inserting queued ticket: %v", ticket.Ticket)
+	}
+	return nil
+}
+
+// StoreDeadLetter persists a ticket that could not be redeemed with the reason
+// for the last failed redemption attempt
+// Storing a ticket that is already persisted is a no-op
+func (db *DB) StoreDeadLetter(ticket *pm.SignedTicket, reason error) error {
+	values, err := signedTicketValues(ticket)
+	if err != nil {
+		return err
+	}
+	glog.V(DEBUG).Infof("db: Inserting dead-lettered ticket from %v, recipientRandHash %v, senderNonce %d", ticket.Sender.Hex(), ticket.RecipientRandHash.Hex(), ticket.SenderNonce)
+
+	var reasonStr string
+	if reason != nil {
+		reasonStr = reason.Error()
+	}
+
+	_, err = db.insertDeadLetteredTicket.Exec(append(values, reasonStr)...)
+	if err != nil {
+		return errors.Wrapf(err, "failed inserting dead-lettered ticket: %v", ticket.Ticket)
+	}
+	return nil
+}
+
+// signedTicketValues validates a signed ticket and returns the values to insert for
+// the ticketHash, sender, recipient, faceValue, winProb, senderNonce, recipientRandHash, creationRound,
+// creationRoundBlockHash, paramsExpirationBlock, pricePerPixel, sig and recipientRand columns
+func signedTicketValues(ticket *pm.SignedTicket) ([]interface{}, error) {
+	if ticket == nil || ticket.Ticket == nil {
+		return nil, errors.New("cannot store nil ticket")
+	}
+	if ticket.Sig == nil {
+		return nil, errors.New("cannot store nil sig")
+	}
+	if ticket.RecipientRand == nil {
+		return nil, errors.New("cannot store nil recipientRand")
+	}
+
+	var paramsExpirationBlock []byte
+	if ticket.ParamsExpirationBlock != nil {
+		paramsExpirationBlock = ticket.ParamsExpirationBlock.Bytes()
+	}
+	var pricePerPixel string
+	if ticket.PricePerPixel != nil {
+		pricePerPixel = ticket.PricePerPixel.String()
+	}
+
+	return []interface{}{
+		ticket.Hash().Hex(),
+		ticket.Sender.Hex(),
+		ticket.Recipient.Hex(),
+		ticket.FaceValue.Bytes(),
+		ticket.WinProb.Bytes(),
+		ticket.SenderNonce,
+		ticket.RecipientRandHash.Hex(),
+		ticket.CreationRound,
+		ticket.CreationRoundBlockHash.Hex(),
+		paramsExpirationBlock,
+		pricePerPixel,
+		ticket.Sig,
+		ticket.RecipientRand.Bytes(),
+	}, nil
+}
+
+// RemoveQueuedTicket removes the persisted queued ticket with the provided hash
+func (db *DB) RemoveQueuedTicket(id ethcommon.Hash) error {
+	glog.V(DEBUG).Infof("db: Deleting queued ticket %v", id.Hex())
+
+	_, err := db.deleteQueuedTicket.Exec(id.Hex())
+	if err != nil {
+		return errors.Wrapf(err, "failed deleting queued ticket %v", id.Hex())
+	}
+	return nil
+}
+
+// LoadQueuedTickets fetches all persisted queued tickets ordered by the time they were first stored
+func (db *DB) LoadQueuedTickets() ([]*pm.SignedTicket, error) {
+	rows, err := db.selectQueuedTickets.Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed loading queued tickets")
+	}
+	defer rows.Close()
+
+	var tickets []*pm.SignedTicket
+	for rows.Next() {
+		var sender, recipient, recipientRandHash, creationRoundBlockHash, pricePerPixel string
+		var faceValue, winProb, paramsExpirationBlock, sig, recipientRand []byte
+		var senderNonce uint32
+		var creationRound int64
+
+		if err := rows.Scan(&sender, &recipient, &faceValue, &winProb, &senderNonce, &recipientRandHash, &creationRound, &creationRoundBlockHash, &paramsExpirationBlock, &pricePerPixel, &sig, &recipientRand); err != nil {
+			return nil, errors.Wrap(err, "failed scanning a queued ticket row")
+		}
+
+		ticket := &pm.Ticket{
+			Sender:                 ethcommon.HexToAddress(sender),
+			Recipient:              ethcommon.HexToAddress(recipient),
+			FaceValue:              new(big.Int).SetBytes(faceValue),
+			WinProb:                new(big.Int).SetBytes(winProb),
+			SenderNonce:            senderNonce,
+			RecipientRandHash:      ethcommon.HexToHash(recipientRandHash),
+			CreationRound:          creationRound,
+			CreationRoundBlockHash: ethcommon.HexToHash(creationRoundBlockHash),
+			ParamsExpirationBlock:  new(big.Int).SetBytes(paramsExpirationBlock),
+		}
+		if pricePerPixel != "" {
+			price, ok := new(big.Rat).SetString(pricePerPixel)
+			if !ok {
+				return nil, fmt.Errorf("invalid pricePerPixel %v for queued ticket", pricePerPixel)
+			}
+			ticket.PricePerPixel = price
+		}
+
+		tickets = append(tickets, &pm.SignedTicket{
+			Ticket:        ticket,
+			Sig:           sig,
+			RecipientRand: new(big.Int).SetBytes(recipientRand),
+		})
+	}
+
+	return tickets, nil
+}
+
 // We are building a query string instead of using a prepared statement because prepared statements don't
 // support IN queries. We want to use IN for the performance benefit, rather than running len(sessionIDs)
 // queries.
@@ -2,6 +2,7 @@ package common
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -935,6 +936,112 @@ func TestLoadWinningTicket_GivenNonexistentSessionID_ReturnsEmptySlicesNoError(t
 	assert.Len(recipientRands, 0)
 }
 
+func TestQueuedTickets_StoreLoadRemove(t *testing.T) {
+	dbh, dbraw, err := TempDB(t)
+	defer dbh.Close()
+	defer dbraw.Close()
+	require := require.New(t)
+	require.Nil(err)
+	assert := assert.New(t)
+
+	tickets, err := dbh.LoadQueuedTickets()
+	assert.Nil(err)
+	assert.Len(tickets, 0)
+
+	_, ticket0, sig0, recipientRand0 := defaultWinningTicket(t)
+	ticket0.CreationRound = 10
+	ticket0.CreationRoundBlockHash = pm.RandHash()
+	ticket0.ParamsExpirationBlock = big.NewInt(100)
+	ticket0.PricePerPixel = big.NewRat(1, 3)
+	_, ticket1, sig1, recipientRand1 := defaultWinningTicket(t)
+	queued := []*pm.SignedTicket{
+		{Ticket: ticket0, Sig: sig0, RecipientRand: recipientRand0},
+		{Ticket: ticket1, Sig: sig1, RecipientRand: recipientRand1},
+	}
+
+	for _, ticket := range queued {
+		err = dbh.StoreQueuedTicket(ticket)
+		require.Nil(err)
+	}
+	// Storing a ticket that is already persisted is a no-op
+	err = dbh.StoreQueuedTicket(queued[0])
+	require.Nil(err)
+	assert.Equal(2, getRowCountOrFatal("SELECT count(*) FROM queuedTickets", dbraw, t))
+
+	tickets, err = dbh.LoadQueuedTickets()
+	require.Nil(err)
+	require.Len(tickets, 2)
+	for i, ticket := range tickets {
+		assert.Equal(queued[i].Hash(), ticket.Hash())
+		assert.Equal(queued[i].Sig, ticket.Sig)
+		assert.Equal(queued[i].RecipientRand, ticket.RecipientRand)
+	}
+	assert.Equal(ticket0.ParamsExpirationBlock, tickets[0].ParamsExpirationBlock)
+	assert.Zero(ticket0.PricePerPixel.Cmp(tickets[0].PricePerPixel))
+	assert.Nil(tickets[1].PricePerPixel)
+
+	err = dbh.RemoveQueuedTicket(ticket0.Hash())
+	require.Nil(err)
+	// Removing a ticket that is not persisted is a no-op
+	err = dbh.RemoveQueuedTicket(ticket0.Hash())
+	require.Nil(err)
+
+	tickets, err = dbh.LoadQueuedTickets()
+	require.Nil(err)
+	require.Len(tickets, 1)
+	assert.Equal(ticket1.Hash(), tickets[0].Hash())
+}
+
+func TestStoreDeadLetter(t *testing.T) {
+	dbh, dbraw, err := TempDB(t)
+	defer dbh.Close()
+	defer dbraw.Close()
+	require := require.New(t)
+	require.Nil(err)
+	assert := assert.New(t)
+
+	_, ticket, sig, recipientRand := defaultWinningTicket(t)
+	signedTicket := &pm.SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}
+
+	err = dbh.StoreDeadLetter(signedTicket, errors.New("redemption error"))
+	require.Nil(err)
+	// Storing a ticket that is already persisted is a no-op
+	err = dbh.StoreDeadLetter(signedTicket, errors.New("redemption error"))
+	require.Nil(err)
+	assert.Equal(1, getRowCountOrFatal("SELECT count(*) FROM deadLetteredTickets", dbraw, t))
+
+	var ticketHash, reason string
+	row := dbraw.QueryRow("SELECT ticketHash, reason FROM deadLetteredTickets")
+	require.Nil(row.Scan(&ticketHash, &reason))
+	assert.Equal(ticket.Hash().Hex(), ticketHash)
+	assert.Equal("redemption error", reason)
+
+	err = dbh.StoreDeadLetter(nil, errors.New("redemption error"))
+	assert.EqualError(err, "cannot store nil ticket")
+	err = dbh.StoreDeadLetter(&pm.SignedTicket{Ticket: ticket, RecipientRand: recipientRand}, errors.New("redemption error"))
+	assert.EqualError(err, "cannot store nil sig")
+	assert.Equal(1, getRowCountOrFatal("SELECT count(*) FROM deadLetteredTickets", dbraw, t))
+}
+
+func TestStoreQueuedTicket_GivenNilInputs_ReturnsError(t *testing.T) {
+	dbh, dbraw, err := TempDB(t)
+	defer dbh.Close()
+	defer dbraw.Close()
+	require := require.New(t)
+	require.Nil(err)
+	assert := assert.New(t)
+
+	_, ticket, sig, recipientRand := defaultWinningTicket(t)
+
+	err = dbh.StoreQueuedTicket(nil)
+	assert.EqualError(err, "cannot store nil ticket")
+	err = dbh.StoreQueuedTicket(&pm.SignedTicket{Ticket: ticket, RecipientRand: recipientRand})
+	assert.EqualError(err, "cannot store nil sig")
+	err = dbh.StoreQueuedTicket(&pm.SignedTicket{Ticket: ticket, Sig: sig})
+	assert.EqualError(err, "cannot store nil recipientRand")
+	assert.Equal(0, getRowCountOrFatal("SELECT count(*) FROM queuedTickets", dbraw, t))
+}
+
 func TestLoadWinningTicket_GivenEmptySessionID_ReturnsEmptySlicesNoError(t *testing.T) {
 	dbh, dbraw, err := TempDB(t)
 	defer dbh.Close()
@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/glog"
 	"github.com/livepeer/go-livepeer/net"
@@ -34,6 +35,7 @@ const priceScalingFactor = int64(1000)
 
 var (
 	ErrParseBigInt = fmt.Errorf("failed to parse big integer")
+	ErrParseAddr   = fmt.Errorf("failed to parse ETH address")
 	ErrProfile     = fmt.Errorf("failed to parse profile")
 
 	ErrFormatProto = fmt.Errorf("unknown VideoProfile format for protobufs")
@@ -61,6 +63,24 @@ func ParseBigInt(num string) (*big.Int, error) {
 	}
 }
 
+// ParseAddresses parses a comma-separated list of ETH addresses
+// An empty string is parsed as an empty list
+func ParseAddresses(addrs string) ([]ethcommon.Address, error) {
+	var res []ethcommon.Address
+	for _, addr := range strings.Split(addrs, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		if !ethcommon.IsHexAddress(addr) {
+			return nil, errors.Wrap(ErrParseAddr, addr)
+		}
+		res = append(res, ethcommon.HexToAddress(addr))
+	}
+
+	return res, nil
+}
+
 func WaitUntil(waitTime time.Duration, condition func() bool) {
 	start := time.Now()
 	for time.Since(start) < waitTime {
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/livepeer/go-livepeer/net"
 	"github.com/livepeer/lpms/ffmpeg"
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,23 @@ func TestTxDataToVideoProfile(t *testing.T) {
 	}
 }
 
+func TestParseAddresses(t *testing.T) {
+	assert := assert.New(t)
+
+	addrs, err := ParseAddresses("")
+	assert.Nil(err)
+	assert.Len(addrs, 0)
+
+	addr0 := ethcommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	addr1 := ethcommon.HexToAddress("0x0000000000000000000000000000000000000002")
+	addrs, err = ParseAddresses(addr0.Hex() + ", " + addr1.Hex())
+	assert.Nil(err)
+	assert.Equal([]ethcommon.Address{addr0, addr1}, addrs)
+
+	_, err = ParseAddresses(addr0.Hex() + ",foo")
+	assert.EqualError(err, "foo: failed to parse ETH address")
+}
+
 func TestVideoProfileBytes(t *testing.T) {
 	if len(VideoProfileByteLookup) != len(VideoProfileNameLookup) {
 		t.Error("Video profile byte map was not created correctly")
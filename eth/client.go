@@ -95,6 +95,7 @@ type LivepeerEthClient interface {
 	IsUsedTicket(ticket *pm.Ticket) (bool, error)
 	GetSenderInfo(addr ethcommon.Address) (*pm.SenderInfo, error)
 	UnlockPeriod() (*big.Int, error)
+	TicketValidityPeriod() (*big.Int, error)
 	ClaimedReserve(reserveHolder ethcommon.Address, claimant ethcommon.Address) (*big.Int, error)
 
 	// Parameters
@@ -142,6 +142,11 @@ func (m *MockClient) UnlockPeriod() (*big.Int, error) {
 	return mockBigInt(args, 0), args.Error(1)
 }
 
+func (m *MockClient) TicketValidityPeriod() (*big.Int, error) {
+	args := m.Called()
+	return mockBigInt(args, 0), args.Error(1)
+}
+
 func (m *MockClient) Account() accounts.Account {
 	args := m.Called()
 
@@ -316,6 +321,9 @@ func (e *StubClient) ClaimableReserve(reserveHolder, claimant ethcommon.Address)
 func (e *StubClient) UnlockPeriod() (*big.Int, error) {
 	return nil, nil
 }
+func (e *StubClient) TicketValidityPeriod() (*big.Int, error) {
+	return nil, nil
+}
 
 // Parameters
 func (c *StubClient) GetTranscoderPoolMaxSize() (*big.Int, error) { return big.NewInt(0), nil }
@@ -112,6 +112,12 @@ type (
 		mWinningTicketsRecv    *stats.Int64Measure
 		mValueRedeemed         *stats.Float64Measure
 		mTicketRedemptionError *stats.Int64Measure
+		mRedemptionCircuit     *stats.Int64Measure
+		mRedemptionDropped     *stats.Int64Measure
+		mRedemptionDeferred    *stats.Int64Measure
+		mRedemptionDeadLetter  *stats.Int64Measure
+		mShadowTicketsRedeemed *stats.Int64Measure
+		mShadowValueRedeemed   *stats.Float64Measure
 		mSuggestedGasPrice     *stats.Float64Measure
 		mTranscodingPrice      *stats.Float64Measure
 
@@ -217,6 +223,12 @@ func InitCensus(nodeType, nodeID, version string) {
 	census.mWinningTicketsRecv = stats.Int64("winning_tickets_recv", "WinningTicketsRecv", "tot")
 	census.mValueRedeemed = stats.Float64("value_redeemed", "ValueRedeemed", "gwei")
 	census.mTicketRedemptionError = stats.Int64("ticket_redemption_errors", "TicketRedemptionError", "tot")
+	census.mRedemptionCircuit = stats.Int64("ticket_redemption_circuit_state", "TicketRedemptionCircuitState", stats.UnitDimensionless)
+	census.mRedemptionDropped = stats.Int64("ticket_redemption_dropped", "TicketRedemptionDropped", "tot")
+	census.mRedemptionDeferred = stats.Int64("ticket_redemption_deferred", "TicketRedemptionDeferred", "tot")
+	census.mRedemptionDeadLetter = stats.Int64("ticket_redemption_dead_letter", "TicketRedemptionDeadLetter", "tot")
+	census.mShadowTicketsRedeemed = stats.Int64("shadow_tickets_redeemed", "ShadowTicketsRedeemed", "tot")
+	census.mShadowValueRedeemed = stats.Float64("shadow_value_redeemed", "ShadowValueRedeemed", "gwei")
 	census.mSuggestedGasPrice = stats.Float64("suggested_gas_price", "SuggestedGasPrice", "gwei")
 	census.mTranscodingPrice = stats.Float64("transcoding_price", "TranscodingPrice", "wei")
 
@@ -507,6 +519,48 @@ func InitCensus(nodeType, nodeID, version string) {
 			TagKeys:     append([]tag.Key{census.kSender}, baseTags...),
 			Aggregation: view.Sum(),
 		},
+		{
+			Name:        "ticket_redemption_circuit_state",
+			Measure:     census.mRedemptionCircuit,
+			Description: "State of the ticket redemption circuit breaker (0 = closed, 1 = open, 2 = half-open)",
+			TagKeys:     baseTags,
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        "ticket_redemption_dropped",
+			Measure:     census.mRedemptionDropped,
+			Description: "Queued tickets dropped because they were no longer redeemable",
+			TagKeys:     append([]tag.Key{census.kSender}, baseTags...),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        "ticket_redemption_deferred",
+			Measure:     census.mRedemptionDeferred,
+			Description: "Ticket redemptions deferred because the gas price was too high",
+			TagKeys:     append([]tag.Key{census.kSender}, baseTags...),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        "ticket_redemption_dead_letter",
+			Measure:     census.mRedemptionDeadLetter,
+			Description: "Tickets dropped after exhausting redemption attempts",
+			TagKeys:     append([]tag.Key{census.kSender}, baseTags...),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        "shadow_tickets_redeemed",
+			Measure:     census.mShadowTicketsRedeemed,
+			Description: "Winning tickets that would have been redeemed in shadow mode",
+			TagKeys:     append([]tag.Key{census.kSender}, baseTags...),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        "shadow_value_redeemed",
+			Measure:     census.mShadowValueRedeemed,
+			Description: "Winning ticket value that would have been redeemed in shadow mode",
+			TagKeys:     baseTags,
+			Aggregation: view.Sum(),
+		},
 		{
 			Name:        "suggested_gas_price",
 			Measure:     census.mSuggestedGasPrice,
@@ -1180,6 +1234,66 @@ func TicketRedemptionError(sender string) {
 	stats.Record(ctx, census.mTicketRedemptionError.M(1))
 }
 
+// TicketRedemptionCircuitState records the state of the ticket redemption circuit breaker
+func TicketRedemptionCircuitState(state int) {
+	census.lock.Lock()
+	defer census.lock.Unlock()
+
+	stats.Record(census.ctx, census.mRedemptionCircuit.M(int64(state)))
+}
+
+// TicketRedemptionDropped records a queued ticket that was dropped because it was no longer redeemable
+func TicketRedemptionDropped(sender string) {
+	census.lock.Lock()
+	defer census.lock.Unlock()
+
+	ctx, err := tag.New(census.ctx, tag.Insert(census.kSender, sender))
+	if err != nil {
+		glog.Fatal(err)
+	}
+
+	stats.Record(ctx, census.mRedemptionDropped.M(1))
+}
+
+// TicketRedemptionDeferred records a ticket redemption that was deferred because the gas price was too high
+func TicketRedemptionDeferred(sender string) {
+	census.lock.Lock()
+	defer census.lock.Unlock()
+
+	ctx, err := tag.New(census.ctx, tag.Insert(census.kSender, sender))
+	if err != nil {
+		glog.Fatal(err)
+	}
+
+	stats.Record(ctx, census.mRedemptionDeferred.M(1))
+}
+
+// TicketRedemptionDeadLetter records a ticket that was dropped after exhausting its redemption attempts
+func TicketRedemptionDeadLetter(sender string) {
+	census.lock.Lock()
+	defer census.lock.Unlock()
+
+	ctx, err := tag.New(census.ctx, tag.Insert(census.kSender, sender))
+	if err != nil {
+		glog.Fatal(err)
+	}
+
+	stats.Record(ctx, census.mRedemptionDeadLetter.M(1))
+}
+
+// ShadowTicketRedeemed records a winning ticket from a sender that would have been redeemed in shadow mode
+func ShadowTicketRedeemed(sender string, value *big.Int) {
+	census.lock.Lock()
+	defer census.lock.Unlock()
+
+	ctx, err := tag.New(census.ctx, tag.Insert(census.kSender, sender))
+	if err != nil {
+		glog.Fatal(err)
+	}
+
+	stats.Record(ctx, census.mShadowTicketsRedeemed.M(1), census.mShadowValueRedeemed.M(wei2gwei(value)))
+}
+
 // SuggestedGasPrice records the last suggested gas price
 func SuggestedGasPrice(gasPrice *big.Int) {
 	census.lock.Lock()
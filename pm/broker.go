@@ -60,6 +60,10 @@ type Broker interface {
 	// IsUsedTicket checks if a ticket has been used
 	IsUsedTicket(ticket *Ticket) (bool, error)
 
+	// TicketValidityPeriod returns the number of rounds after its creation round
+	// during which a ticket can be redeemed
+	TicketValidityPeriod() (*big.Int, error)
+
 	// CheckTx waits for a transaction to confirm on-chain and returns an error
 	// if the transaction failed
 	CheckTx(tx *types.Transaction) error
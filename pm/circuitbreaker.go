@@ -0,0 +1,142 @@
+package pm
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a circuit breaker
+type CircuitState int
+
+const (
+	// CircuitClosed indicates that operations are allowed
+	CircuitClosed CircuitState = iota
+	// CircuitOpen indicates that operations are not allowed until the cooldown elapses
+	CircuitOpen
+	// CircuitHalfOpen indicates that a single trial operation is allowed to test for recovery
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitBreaker tracks consecutive failures of an operation. After threshold
+// consecutive failures the circuit opens and operations are not allowed for the
+// duration of the cooldown. Once the cooldown elapses the circuit is half-open and
+// a single trial operation is allowed. If the trial succeeds the circuit closes and if
+// it fails the circuit opens again for another cooldown
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	// onStateChange is called with the new state whenever the state of the circuit changes
+	onStateChange func(CircuitState)
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt int64
+}
+
+// newCircuitBreaker returns a circuitBreaker that opens after threshold consecutive failures
+// If threshold <= 0 the circuit breaker is disabled and always allows operations
+// If onStateChange is not nil it is called with the new state whenever the state of the circuit changes
+func newCircuitBreaker(threshold int, cooldown time.Duration, onStateChange func(CircuitState)) *circuitBreaker {
+	return &circuitBreaker{
+		threshold:     threshold,
+		cooldown:      cooldown,
+		onStateChange: onStateChange,
+		state:         CircuitClosed,
+	}
+}
+
+// Allow returns whether an operation should be attempted
+func (cb *circuitBreaker) Allow() bool {
+	if cb.threshold <= 0 {
+		return true
+	}
+
+	cb.mu.Lock()
+
+	switch cb.state {
+	case CircuitOpen:
+		if unixNow()-cb.openedAt < int64(cb.cooldown.Seconds()) {
+			cb.mu.Unlock()
+			return false
+		}
+		// Allow a single trial operation
+		cb.state = CircuitHalfOpen
+		cb.mu.Unlock()
+
+		cb.stateChanged(CircuitHalfOpen)
+		return true
+	case CircuitHalfOpen:
+		cb.mu.Unlock()
+		// A trial operation is already in flight
+		return false
+	default:
+		cb.mu.Unlock()
+		return true
+	}
+}
+
+// Success records a successful operation and closes the circuit
+func (cb *circuitBreaker) Success() {
+	cb.mu.Lock()
+	prev := cb.state
+	cb.failures = 0
+	cb.state = CircuitClosed
+	cb.mu.Unlock()
+
+	if prev != CircuitClosed {
+		cb.stateChanged(CircuitClosed)
+	}
+}
+
+// Failure records a failed operation and opens the circuit if the failure threshold is reached
+// or if the failed operation was a trial operation
+func (cb *circuitBreaker) Failure() {
+	if cb.threshold <= 0 {
+		return
+	}
+
+	cb.mu.Lock()
+	prev := cb.state
+	cb.failures++
+	if cb.state == CircuitHalfOpen || cb.failures >= cb.threshold {
+		cb.state = CircuitOpen
+		cb.openedAt = unixNow()
+	}
+	state := cb.state
+	cb.mu.Unlock()
+
+	if state != prev {
+		cb.stateChanged(state)
+	}
+}
+
+// State returns the current state of the circuit
+func (cb *circuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return cb.state
+}
+
+// stateChanged notifies onStateChange of a new state of the circuit
+// It should be called without holding cb.mu
+func (cb *circuitBreaker) stateChanged(state CircuitState) {
+	if cb.onStateChange != nil {
+		cb.onStateChange(state)
+	}
+}
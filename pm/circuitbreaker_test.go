@@ -0,0 +1,111 @@
+package pm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	assert := assert.New(t)
+
+	cb := newCircuitBreaker(0, time.Minute, nil)
+	for i := 0; i < 10; i++ {
+		cb.Failure()
+	}
+	assert.True(cb.Allow())
+	assert.Equal(CircuitClosed, cb.State())
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	assert := assert.New(t)
+	setTime(0)
+
+	cb := newCircuitBreaker(3, time.Minute, nil)
+
+	cb.Failure()
+	cb.Failure()
+	assert.True(cb.Allow())
+	assert.Equal(CircuitClosed, cb.State())
+
+	cb.Failure()
+	assert.Equal(CircuitOpen, cb.State())
+	assert.False(cb.Allow())
+
+	// A success before the threshold resets the failure count
+	cb = newCircuitBreaker(3, time.Minute, nil)
+	cb.Failure()
+	cb.Failure()
+	cb.Success()
+	cb.Failure()
+	assert.True(cb.Allow())
+	assert.Equal(CircuitClosed, cb.State())
+}
+
+func TestCircuitBreaker_HalfOpen(t *testing.T) {
+	assert := assert.New(t)
+	setTime(0)
+
+	cb := newCircuitBreaker(1, time.Minute, nil)
+	cb.Failure()
+	assert.False(cb.Allow())
+
+	// Cooldown not elapsed
+	increaseTime(59)
+	assert.False(cb.Allow())
+
+	// Cooldown elapsed, allow a single trial
+	increaseTime(1)
+	assert.True(cb.Allow())
+	assert.Equal(CircuitHalfOpen, cb.State())
+	assert.False(cb.Allow())
+
+	// Trial fails, circuit opens for another cooldown
+	cb.Failure()
+	assert.Equal(CircuitOpen, cb.State())
+	assert.False(cb.Allow())
+
+	// Trial succeeds, circuit closes
+	increaseTime(60)
+	assert.True(cb.Allow())
+	cb.Success()
+	assert.Equal(CircuitClosed, cb.State())
+	assert.True(cb.Allow())
+	assert.True(cb.Allow())
+}
+
+func TestCircuitBreaker_OnStateChange(t *testing.T) {
+	assert := assert.New(t)
+	setTime(0)
+
+	var states []CircuitState
+	cb := newCircuitBreaker(2, time.Minute, func(state CircuitState) {
+		states = append(states, state)
+	})
+
+	// Failures below the threshold and successes while closed do not change the state
+	cb.Failure()
+	cb.Success()
+	cb.Failure()
+	assert.Nil(states)
+
+	cb.Failure()
+	assert.Equal([]CircuitState{CircuitOpen}, states)
+
+	// Checking the circuit before the cooldown elapses does not change the state
+	assert.False(cb.Allow())
+	assert.Equal([]CircuitState{CircuitOpen}, states)
+
+	increaseTime(60)
+	assert.True(cb.Allow())
+	assert.Equal([]CircuitState{CircuitOpen, CircuitHalfOpen}, states)
+
+	cb.Failure()
+	assert.Equal([]CircuitState{CircuitOpen, CircuitHalfOpen, CircuitOpen}, states)
+
+	increaseTime(60)
+	assert.True(cb.Allow())
+	cb.Success()
+	assert.Equal([]CircuitState{CircuitOpen, CircuitHalfOpen, CircuitOpen, CircuitHalfOpen, CircuitClosed}, states)
+}
@@ -3,8 +3,8 @@ package pm
 import (
 	"math/big"
 	"sync"
-	"sync/atomic"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/golang/glog"
 )
@@ -17,25 +17,245 @@ type RedeemableEmitter interface {
 	Redeemable() chan *SignedTicket
 }
 
+// TicketQueue is an interface that describes methods for storing winning tickets
+// that are in line for redemption on-chain. Implementations must be safe for
+// concurrent use
+type TicketQueue interface {
+	// Enqueue adds a ticket to the back of the queue
+	Enqueue(ticket *SignedTicket)
+
+	// Dequeue removes and returns the ticket at the front of the queue
+	// It returns nil if the queue is empty
+	// A ticket that is persisted by the queue remains persisted until it is acknowledged with Ack
+	Dequeue() *SignedTicket
+
+	// Ack acknowledges that the ticket with the provided hash was dequeued and will not be queued again
+	// because it was submitted for redemption or dropped
+	Ack(id ethcommon.Hash)
+
+	// RemoveByID removes the ticket with the provided hash from the queue
+	// It returns whether the ticket was found in the queue
+	RemoveByID(id ethcommon.Hash) bool
+
+	// RemoveBySender removes all tickets from the provided sender from the queue
+	// It returns the number of tickets removed
+	RemoveBySender(sender ethcommon.Address) int
+
+	// Snapshot returns a copy of the tickets in the queue ordered from front to back
+	Snapshot() []*SignedTicket
+
+	// Len returns the number of tickets in the queue
+	Len() int
+}
+
+// memTicketQueue is an in-memory implementation of the TicketQueue interface
+type memTicketQueue struct {
+	mu      sync.Mutex
+	tickets []*SignedTicket
+}
+
+// NewMemTicketQueue returns an in-memory TicketQueue
+func NewMemTicketQueue() TicketQueue {
+	return &memTicketQueue{}
+}
+
+// Enqueue adds a ticket to the back of the queue
+func (q *memTicketQueue) Enqueue(ticket *SignedTicket) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.tickets = append(q.tickets, ticket)
+}
+
+// Dequeue removes and returns the ticket at the front of the queue
+// It returns nil if the queue is empty
+func (q *memTicketQueue) Dequeue() *SignedTicket {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.tickets) == 0 {
+		return nil
+	}
+
+	ticket := q.tickets[0]
+	q.tickets[0] = nil
+	q.tickets = q.tickets[1:]
+
+	return ticket
+}
+
+// Ack acknowledges that the ticket with the provided hash will not be queued again
+// This is a no-op because tickets are only held in memory
+func (q *memTicketQueue) Ack(id ethcommon.Hash) {}
+
+// RemoveByID removes the ticket with the provided hash from the queue
+// It returns whether the ticket was found in the queue
+func (q *memTicketQueue) RemoveByID(id ethcommon.Hash) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, ticket := range q.tickets {
+		if ticket.Hash() == id {
+			q.tickets = append(q.tickets[:i], q.tickets[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveBySender removes all tickets from the provided sender from the queue
+// It returns the number of tickets removed
+func (q *memTicketQueue) RemoveBySender(sender ethcommon.Address) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tickets := q.tickets[:0]
+	for _, ticket := range q.tickets {
+		if ticket.Sender != sender {
+			tickets = append(tickets, ticket)
+		}
+	}
+
+	removed := len(q.tickets) - len(tickets)
+	for i := len(tickets); i < len(q.tickets); i++ {
+		q.tickets[i] = nil
+	}
+	q.tickets = tickets
+
+	return removed
+}
+
+// Snapshot returns a copy of the tickets in the queue ordered from front to back
+func (q *memTicketQueue) Snapshot() []*SignedTicket {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tickets := make([]*SignedTicket, len(q.tickets))
+	copy(tickets, q.tickets)
+
+	return tickets
+}
+
+// Len returns the number of tickets in the queue
+func (q *memTicketQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.tickets)
+}
+
+// persistentTicketQueue is an implementation of the TicketQueue interface that
+// persists queued tickets in a QueuedTicketStore in addition to holding them in memory
+// A ticket remains persisted until it is acknowledged after it is submitted for redemption
+// or dropped so that tickets are not lost if the node restarts before they are redeemed
+type persistentTicketQueue struct {
+	mem   TicketQueue
+	store QueuedTicketStore
+
+	// persisted tracks the tickets that are already persisted so that a ticket
+	// that is queued again is not written to the store again
+	persisted map[ethcommon.Hash]bool
+	mu        sync.Mutex
+}
+
+// NewPersistentTicketQueue returns a TicketQueue that persists queued tickets in store
+// The persisted tickets can be queued again after a restart using RequeueStoredTickets
+func NewPersistentTicketQueue(store QueuedTicketStore) TicketQueue {
+	return &persistentTicketQueue{
+		mem:       NewMemTicketQueue(),
+		store:     store,
+		persisted: make(map[ethcommon.Hash]bool),
+	}
+}
+
+// Enqueue persists a ticket if it is not already persisted and adds it to the back of the queue
+func (q *persistentTicketQueue) Enqueue(ticket *SignedTicket) {
+	id := ticket.Hash()
+
+	q.mu.Lock()
+	persisted := q.persisted[id]
+	q.mu.Unlock()
+
+	if !persisted {
+		if err := q.store.StoreQueuedTicket(ticket); err != nil {
+			glog.Errorf("error persisting queued ticket sender=%x recipientRandHash=%x senderNonce=%v err=%v", ticket.Sender, ticket.RecipientRandHash, ticket.SenderNonce, err)
+		} else {
+			q.mu.Lock()
+			q.persisted[id] = true
+			q.mu.Unlock()
+		}
+	}
+
+	q.mem.Enqueue(ticket)
+}
+
+// Dequeue removes and returns the ticket at the front of the queue
+// It returns nil if the queue is empty
+// The ticket remains persisted until it is acknowledged with Ack
+func (q *persistentTicketQueue) Dequeue() *SignedTicket {
+	return q.mem.Dequeue()
+}
+
+// Ack removes the persisted ticket with the provided hash
+func (q *persistentTicketQueue) Ack(id ethcommon.Hash) {
+	q.remove(id)
+}
+
+// RemoveByID removes the ticket with the provided hash from the queue
+// It returns whether the ticket was found in the queue
+func (q *persistentTicketQueue) RemoveByID(id ethcommon.Hash) bool {
+	q.remove(id)
+
+	return q.mem.RemoveByID(id)
+}
+
+// RemoveBySender removes all tickets from the provided sender from the queue
+// It returns the number of tickets removed
+func (q *persistentTicketQueue) RemoveBySender(sender ethcommon.Address) int {
+	for _, ticket := range q.mem.Snapshot() {
+		if ticket.Sender == sender {
+			q.remove(ticket.Hash())
+		}
+	}
+
+	return q.mem.RemoveBySender(sender)
+}
+
+// Snapshot returns a copy of the tickets in the queue ordered from front to back
+func (q *persistentTicketQueue) Snapshot() []*SignedTicket {
+	return q.mem.Snapshot()
+}
+
+// Len returns the number of tickets in the queue
+func (q *persistentTicketQueue) Len() int {
+	return q.mem.Len()
+}
+
+func (q *persistentTicketQueue) remove(id ethcommon.Hash) {
+	if err := q.store.RemoveQueuedTicket(id); err != nil {
+		glog.Errorf("error removing persisted queued ticket hash=%x err=%v", id, err)
+		return
+	}
+
+	q.mu.Lock()
+	delete(q.persisted, id)
+	q.mu.Unlock()
+}
+
 // ticketQueue is a queue of winning tickets that are in line for redemption on-chain.
 // A recipient will have a ticketQueue per sender that it is actively receiving tickets from.
 // If a sender's max float is insufficient to cover the face value of a ticket it is added to the queue.
 // A ticket is pulled from the queue by the recipient when a sender has sufficient max float to cover
 // the next ticket in the queue
 //
+// The tickets themselves are held in a TicketQueue which allows the storage to be swapped
+// out independently of the redemption loop
+//
 // Based off of: https://github.com/lightningnetwork/lnd/blob/master/htlcswitch/queue.go
 type ticketQueue struct {
-	queue []*SignedTicket
-
-	// queueLen is an internal length counter that keeps track
-	// of the size of the queue. We maintain this counter instead
-	// of reading len(queue) in order to avoid acquiring the main lock
-	// used by the queue loop goroutine
-	queueLen int32
-
-	// cond is a conditional variable that is used by the main
-	// queue loop goroutine to wait for new tickets added to the queue
-	cond *sync.Cond
+	// store holds the tickets in the queue
+	store TicketQueue
 
 	// blockSub returns a subscription to receive the last seen block number
 	blockSub func(chan<- *big.Int) event.Subscription
@@ -48,9 +268,9 @@ type ticketQueue struct {
 	quit chan struct{}
 }
 
-func newTicketQueue(blockSub func(chan<- *big.Int) event.Subscription) *ticketQueue {
+func newTicketQueue(store TicketQueue, blockSub func(chan<- *big.Int) event.Subscription) *ticketQueue {
 	return &ticketQueue{
-		cond:       sync.NewCond(&sync.Mutex{}),
+		store:      store,
 		blockSub:   blockSub,
 		redeemable: make(chan *SignedTicket),
 		quit:       make(chan struct{}),
@@ -76,14 +296,7 @@ func (q *ticketQueue) Stop() {
 // other tickets to the queue and wait for the transactions to confirm to check if the sender's
 // max float is sufficient to cover the tickets in the queue
 func (q *ticketQueue) Add(ticket *SignedTicket) {
-	// Lock conditional variable while adding to the queue
-	q.cond.L.Lock()
-	q.queue = append(q.queue, ticket)
-	atomic.AddInt32(&q.queueLen, 1)
-	q.cond.L.Unlock()
-
-	// Signal that there are tickets in the queue
-	q.cond.Signal()
+	q.store.Enqueue(ticket)
 }
 
 // Redeemable returns a channel that a consumer can use to receive tickets that
@@ -93,9 +306,14 @@ func (q *ticketQueue) Redeemable() chan *SignedTicket {
 	return q.redeemable
 }
 
+// Ack acknowledges that a ticket received from the queue was submitted for redemption or dropped
+func (q *ticketQueue) Ack(ticket *SignedTicket) {
+	q.store.Ack(ticket.Hash())
+}
+
 // Length returns the current length of the queue
 func (q *ticketQueue) Length() int32 {
-	return atomic.LoadInt32(&q.queueLen)
+	return int32(q.store.Len())
 }
 
 // startQueueLoop blocks until the ticket queue is non-empty. When the queue is non-empty
@@ -119,11 +337,13 @@ func (q *ticketQueue) startQueueLoop() {
 		case latestBlock := <-blockNums:
 			numTickets := q.Length()
 			for i := 0; i < int(numTickets); i++ {
-				q.cond.L.Lock()
-				nextTicket := q.queue[0]
-				q.cond.L.Unlock()
-				q.removeHead()
-				if nextTicket.ParamsExpirationBlock.Cmp(latestBlock) <= 0 {
+				nextTicket := q.store.Dequeue()
+				if nextTicket == nil {
+					break
+				}
+				// Tickets with a failed redemption are held back until the retry backoff elapses
+				retryReady := nextTicket.nextRedemptionBlock == nil || nextTicket.nextRedemptionBlock.Cmp(latestBlock) <= 0
+				if nextTicket.ParamsExpirationBlock.Cmp(latestBlock) <= 0 && retryReady {
 					select {
 					case q.redeemable <- nextTicket:
 					case <-q.quit:
@@ -139,13 +359,3 @@ func (q *ticketQueue) startQueueLoop() {
 		}
 	}
 }
-
-// removeHead removes the head of the queue
-func (q *ticketQueue) removeHead() {
-	// Lock conditional variable while removing from the queue
-	q.cond.L.Lock()
-	q.queue[0] = nil
-	q.queue = q.queue[1:]
-	atomic.AddInt32(&q.queueLen, -1)
-	q.cond.L.Unlock()
-}
@@ -7,13 +7,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func defaultSignedTicket(senderNonce uint32) *SignedTicket {
 	return &SignedTicket{
-		&Ticket{FaceValue: big.NewInt(50), SenderNonce: senderNonce, ParamsExpirationBlock: big.NewInt(0)},
-		[]byte("foo"),
-		big.NewInt(7),
+		Ticket:        &Ticket{FaceValue: big.NewInt(50), SenderNonce: senderNonce, ParamsExpirationBlock: big.NewInt(0)},
+		Sig:           []byte("foo"),
+		RecipientRand: big.NewInt(7),
 	}
 }
 
@@ -52,7 +53,7 @@ func TestTicketQueueLoop(t *testing.T) {
 
 	tm := &stubTimeManager{}
 
-	q := newTicketQueue(tm.SubscribeBlocks)
+	q := newTicketQueue(NewMemTicketQueue(), tm.SubscribeBlocks)
 	q.Start()
 	defer q.Stop()
 
@@ -83,7 +84,7 @@ func TestTicketQueueLoop(t *testing.T) {
 	// Queue should contain only the non-expired ticket now
 	time.Sleep(time.Millisecond * 20)
 	assert.Equal(int32(1), q.Length())
-	assert.Equal(q.queue[0], nonExpTicket)
+	assert.Equal(q.store.Snapshot()[0], nonExpTicket)
 
 	// The popped tickets should be in the same order
 	// that they were added i.e. since we added them
@@ -96,12 +97,82 @@ func TestTicketQueueLoop(t *testing.T) {
 	}
 }
 
+func TestTicketQueueLoop_RetryBackoff(t *testing.T) {
+	assert := assert.New(t)
+
+	tm := &stubTimeManager{}
+
+	q := newTicketQueue(NewMemTicketQueue(), tm.SubscribeBlocks)
+	q.Start()
+	defer q.Stop()
+
+	// Add ticket with a failed redemption that should not be retried before block 10
+	ticket := defaultSignedTicket(0)
+	ticket.nextRedemptionBlock = big.NewInt(10)
+	q.Add(ticket)
+	time.Sleep(time.Millisecond * 20)
+
+	qc := &queueConsumer{}
+	go qc.Wait(1, q)
+
+	tm.blockNumSink <- big.NewInt(9)
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(int32(1), q.Length())
+	assert.Len(qc.Redeemable(), 0)
+
+	tm.blockNumSink <- big.NewInt(10)
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(int32(0), q.Length())
+	assert.Equal([]*SignedTicket{ticket}, qc.Redeemable())
+}
+
+func TestTicketQueueLoop_PersistentTicketQueue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	tm := &stubTimeManager{}
+	store := &stubQueuedTicketStore{}
+
+	q := newTicketQueue(NewPersistentTicketQueue(store), tm.SubscribeBlocks)
+	q.Start()
+	defer q.Stop()
+
+	ticket := defaultSignedTicket(0)
+	ticket.ParamsExpirationBlock = big.NewInt(10)
+	q.Add(ticket)
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(1, store.storeCalls)
+
+	qc := &queueConsumer{}
+	go qc.Wait(1, q)
+
+	// Test a ticket that is not yet redeemable is queued again without writing it to the store again
+	tm.blockNumSink <- big.NewInt(9)
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(int32(1), q.Length())
+	assert.Equal(1, store.storeCalls)
+
+	// Test a redeemable ticket is still persisted until it is acknowledged
+	tm.blockNumSink <- big.NewInt(10)
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(int32(0), q.Length())
+	assert.Equal([]*SignedTicket{ticket}, qc.Redeemable())
+	persisted, err := store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal([]*SignedTicket{ticket}, persisted)
+
+	q.Ack(ticket)
+	persisted, err = store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Len(persisted, 0)
+}
+
 func TestTicketQueueLoopConcurrent(t *testing.T) {
 	assert := assert.New(t)
 
 	tm := &stubTimeManager{}
 
-	q := newTicketQueue(tm.SubscribeBlocks)
+	q := newTicketQueue(NewMemTicketQueue(), tm.SubscribeBlocks)
 	q.Start()
 	defer q.Stop()
 
@@ -154,7 +225,7 @@ func TestTicketQueueConsumeBlockNums(t *testing.T) {
 
 	tm := &stubTimeManager{}
 
-	q := newTicketQueue(tm.SubscribeBlocks)
+	q := newTicketQueue(NewMemTicketQueue(), tm.SubscribeBlocks)
 	q.Start()
 	defer q.Stop()
 	time.Sleep(5 * time.Millisecond)
@@ -164,3 +235,127 @@ func TestTicketQueueConsumeBlockNums(t *testing.T) {
 	// Check that the value is consumed
 	assert.Len(tm.blockNumSink, 0)
 }
+
+func TestMemTicketQueue_EnqueueDequeue(t *testing.T) {
+	assert := assert.New(t)
+
+	q := NewMemTicketQueue()
+	assert.Nil(q.Dequeue())
+	assert.Equal(0, q.Len())
+
+	for i := 0; i < 3; i++ {
+		q.Enqueue(defaultSignedTicket(uint32(i)))
+	}
+	assert.Equal(3, q.Len())
+
+	for i := 0; i < 3; i++ {
+		ticket := q.Dequeue()
+		assert.Equal(uint32(i), ticket.SenderNonce)
+	}
+	assert.Equal(0, q.Len())
+	assert.Nil(q.Dequeue())
+}
+
+func TestMemTicketQueue_RemoveByID(t *testing.T) {
+	assert := assert.New(t)
+
+	q := NewMemTicketQueue()
+	tickets := make([]*SignedTicket, 3)
+	for i := 0; i < 3; i++ {
+		tickets[i] = defaultSignedTicket(uint32(i))
+		tickets[i].WinProb = big.NewInt(100)
+		q.Enqueue(tickets[i])
+	}
+
+	assert.True(q.RemoveByID(tickets[1].Hash()))
+	assert.False(q.RemoveByID(tickets[1].Hash()))
+	assert.Equal([]*SignedTicket{tickets[0], tickets[2]}, q.Snapshot())
+}
+
+func TestMemTicketQueue_RemoveBySender(t *testing.T) {
+	assert := assert.New(t)
+
+	sender := RandAddress()
+	q := NewMemTicketQueue()
+	tickets := make([]*SignedTicket, 4)
+	for i := 0; i < 4; i++ {
+		tickets[i] = defaultSignedTicket(uint32(i))
+		if i%2 == 0 {
+			tickets[i].Sender = sender
+		}
+		q.Enqueue(tickets[i])
+	}
+
+	assert.Equal(2, q.RemoveBySender(sender))
+	assert.Equal(0, q.RemoveBySender(sender))
+	assert.Equal([]*SignedTicket{tickets[1], tickets[3]}, q.Snapshot())
+	assert.Equal(2, q.Len())
+}
+
+func TestMemTicketQueue_Snapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	q := NewMemTicketQueue()
+	assert.Len(q.Snapshot(), 0)
+
+	ticket := defaultSignedTicket(0)
+	q.Enqueue(ticket)
+
+	// Modifying the snapshot should not modify the queue
+	snapshot := q.Snapshot()
+	snapshot[0] = nil
+	assert.Equal([]*SignedTicket{ticket}, q.Snapshot())
+}
+
+func TestPersistentTicketQueue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sender := RandAddress()
+	store := &stubQueuedTicketStore{}
+	q := NewPersistentTicketQueue(store)
+
+	tickets := make([]*SignedTicket, 4)
+	for i := 0; i < 4; i++ {
+		tickets[i] = defaultSignedTicket(uint32(i))
+		tickets[i].WinProb = big.NewInt(100)
+		if i%2 == 0 {
+			tickets[i].Sender = sender
+		}
+		q.Enqueue(tickets[i])
+	}
+	assert.Equal(4, q.Len())
+
+	// Test queued tickets are persisted
+	persisted, err := store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal(tickets, persisted)
+
+	// Test dequeued ticket is still persisted until it is acknowledged
+	assert.Equal(tickets[0], q.Dequeue())
+	persisted, err = store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal(tickets, persisted)
+
+	// Test acknowledged ticket is no longer persisted
+	q.Ack(tickets[0].Hash())
+	persisted, err = store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal(tickets[1:], persisted)
+
+	// Test removed tickets are no longer persisted
+	assert.True(q.RemoveByID(tickets[1].Hash()))
+	assert.Equal(1, q.RemoveBySender(sender))
+	assert.Equal([]*SignedTicket{tickets[3]}, q.Snapshot())
+	persisted, err = store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal([]*SignedTicket{tickets[3]}, persisted)
+
+	// Test ticket is still queued in memory if it cannot be persisted
+	store.storeShouldFail = true
+	q.Enqueue(tickets[0])
+	assert.Equal(2, q.Len())
+	persisted, err = store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal([]*SignedTicket{tickets[3]}, persisted)
+}
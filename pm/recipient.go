@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -20,11 +21,33 @@ var ErrTicketParamsExpired = errors.New("TicketParams expired")
 
 var errInsufficientSenderReserve = errors.New("insufficient sender reserve")
 
+var errSenderReserveBelowMinimum = errors.New("sender reserve below minimum")
+
+var (
+	errSenderDenied     = errors.New("sender is on the denylist")
+	errSenderNotAllowed = errors.New("sender is not on the allowlist")
+)
+
+var errRedemptionCircuitOpen = errors.New("ticket redemption paused after consecutive failures")
+
+var (
+	errTicketExpired = errors.New("ticket expired")
+	errTicketUsed    = errors.New("ticket already redeemed")
+)
+
 // maxWinProb = 2^256 - 1
 var maxWinProb = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
 
 var paramsExpirationBlock = big.NewInt(5)
 
+// recordShadowRedemption records a winning ticket that would have been redeemed in shadow mode
+// This is a wrapper function that can be stubbed in tests
+var recordShadowRedemption = func(sender ethcommon.Address, faceValue *big.Int) {
+	if monitor.Enabled {
+		monitor.ShadowTicketRedeemed(sender.String(), faceValue)
+	}
+}
+
 // Recipient is an interface which describes an object capable
 // of receiving tickets
 type Recipient interface {
@@ -53,6 +76,10 @@ type Recipient interface {
 
 	// EV returns the recipients EV requirement for a ticket as configured on startup
 	EV() *big.Rat
+
+	// SetSenderLists replaces the senders that the recipient accepts tickets from
+	// If allowlist is empty, tickets are accepted from all senders that are not in denylist
+	SetSenderLists(allowlist, denylist []ethcommon.Address)
 }
 
 // TicketParamsConfig contains config information for a recipient to determine
@@ -67,6 +94,56 @@ type TicketParamsConfig struct {
 	// TxCostMultiplier is the desired multiplier of the transaction
 	// cost for redemption
 	TxCostMultiplier int
+
+	// RedemptionFailureThreshold is the number of consecutive ticket redemption
+	// failures after which ticket redemption is paused. If 0, ticket redemption is never paused
+	RedemptionFailureThreshold int
+
+	// RedemptionCooldown is the duration for which ticket redemption is paused
+	// after RedemptionFailureThreshold consecutive failures
+	RedemptionCooldown time.Duration
+
+	// MaxRedemptionAttempts is the number of times submitting a winning ticket for redemption is attempted
+	// before the ticket is dead-lettered. If <= 1, a failed redemption is not retried
+	MaxRedemptionAttempts int
+
+	// RedemptionRetryBackoff is the number of blocks to wait before retrying a failed redemption
+	// The number of blocks to wait doubles after each failed attempt
+	RedemptionRetryBackoff int
+
+	// DeadLetterSink persists winning tickets that could not be redeemed after MaxRedemptionAttempts attempts
+	// or whose redemption transaction failed. If nil, these tickets are only logged
+	DeadLetterSink DeadLetterSink
+
+	// MaxRedemptionGasPrice is the maximum gas price at which winning tickets are redeemed
+	// If the current gas price is higher, redemption is deferred and tickets remain queued
+	// If nil, tickets are redeemed at any gas price
+	MaxRedemptionGasPrice *big.Int
+
+	// RevalidateQueuedTickets enables checking that a queued ticket has not expired
+	// or already been redeemed immediately before submitting it for redemption
+	RevalidateQueuedTickets bool
+
+	// MinSenderReserve is the minimum max float, the part of a sender's reserve allocated to the recipient
+	// that is not pending redemption, required to accept tickets from the sender
+	// If nil, tickets are accepted from senders with any reserve that covers EV
+	MinSenderReserve *big.Int
+
+	// MaxFaceValue is the maximum face value of tickets to bound the value at stake in a single ticket
+	// If nil, the face value is not capped
+	MaxFaceValue *big.Int
+
+	// SenderAllowlist is the initial list of senders that the recipient accepts tickets from
+	// If empty, tickets are accepted from all senders that are not in SenderDenylist
+	SenderAllowlist []ethcommon.Address
+
+	// SenderDenylist is the initial list of senders that the recipient rejects tickets from
+	SenderDenylist []ethcommon.Address
+
+	// ShadowMode enables running the recipient without redeeming winning tickets
+	// Winning tickets are validated and the redemptions that would have been submitted
+	// are logged and recorded, but a redemption transaction is never submitted
+	ShadowMode bool
 }
 
 // GasPriceMonitor defines methods for monitoring gas prices
@@ -92,6 +169,18 @@ type recipient struct {
 	senderNonces     map[string]uint32
 	senderNoncesLock sync.Mutex
 
+	senderAllowlist map[ethcommon.Address]bool
+	senderDenylist  map[ethcommon.Address]bool
+	senderListsLock sync.RWMutex
+
+	// breaker pauses ticket redemption after consecutive redemption failures
+	breaker *circuitBreaker
+
+	// validityPeriod caches the TicketBroker ticket validity period read in validityPeriodRound
+	validityPeriod      *big.Int
+	validityPeriodRound *big.Int
+	validityPeriodLock  sync.Mutex
+
 	cfg TicketParamsConfig
 
 	quit chan struct{}
@@ -115,7 +204,7 @@ func NewRecipient(addr ethcommon.Address, broker Broker, val Validator, store Ti
 // secret. In most cases, NewRecipient should be used instead which will
 // automatically generate a random secret
 func NewRecipientWithSecret(addr ethcommon.Address, broker Broker, val Validator, store TicketStore, gpm GasPriceMonitor, sm SenderMonitor, tm TimeManager, secret [32]byte, cfg TicketParamsConfig) Recipient {
-	return &recipient{
+	r := &recipient{
 		broker:       broker,
 		val:          val,
 		store:        store,
@@ -128,6 +217,15 @@ func NewRecipientWithSecret(addr ethcommon.Address, broker Broker, val Validator
 		cfg:          cfg,
 		quit:         make(chan struct{}),
 	}
+	r.SetSenderLists(cfg.SenderAllowlist, cfg.SenderDenylist)
+	r.breaker = newCircuitBreaker(cfg.RedemptionFailureThreshold, cfg.RedemptionCooldown, r.redemptionCircuitStateChanged)
+
+	// Report the initial state of the circuit so that it is known before the first state change
+	if cfg.RedemptionFailureThreshold > 0 && monitor.Enabled {
+		monitor.TicketRedemptionCircuitState(int(CircuitClosed))
+	}
+
+	return r
 }
 
 // Start initiates the helper goroutines for the recipient
@@ -144,11 +242,21 @@ func (r *recipient) Stop() {
 func (r *recipient) ReceiveTicket(ticket *Ticket, sig []byte, seed *big.Int) (string, bool, error) {
 	recipientRand := r.rand(seed, ticket.Sender, ticket.FaceValue, ticket.WinProb, ticket.ParamsExpirationBlock, ticket.PricePerPixel, ticket.expirationParams())
 
+	// If the sender is not accepted, abort
+	if err := r.checkSenderLists(ticket.Sender); err != nil {
+		return "", false, &FatalReceiveErr{err}
+	}
+
 	// If sender validation check fails, abort
 	if err := r.sm.ValidateSender(ticket.Sender); err != nil {
 		return "", false, &FatalReceiveErr{err}
 	}
 
+	// If the sender's reserve is below the minimum, abort
+	if err := r.checkSenderReserve(ticket.Sender); err != nil {
+		return "", false, &FatalReceiveErr{err}
+	}
+
 	// If any of the basic ticket validity checks fail, abort
 	if err := r.val.ValidateTicket(r.addr, ticket, sig, recipientRand); err != nil {
 		if err.Error() == errInvalidTicketSignature.Error() {
@@ -193,7 +301,7 @@ func (r *recipient) RedeemWinningTickets(sessionIDs []string) error {
 	}
 
 	for i := 0; i < len(tickets); i++ {
-		r.sm.QueueTicket(tickets[i].Sender, &SignedTicket{tickets[i], sigs[i], recipientRands[i]})
+		r.sm.QueueTicket(tickets[i].Sender, &SignedTicket{Ticket: tickets[i], Sig: sigs[i], RecipientRand: recipientRands[i]})
 	}
 
 	return nil
@@ -202,7 +310,7 @@ func (r *recipient) RedeemWinningTickets(sessionIDs []string) error {
 // RedeemWinningTicket redeems a single winning ticket
 func (r *recipient) RedeemWinningTicket(ticket *Ticket, sig []byte, seed *big.Int) error {
 	recipientRand := r.rand(seed, ticket.Sender, ticket.FaceValue, ticket.WinProb, ticket.ParamsExpirationBlock, ticket.PricePerPixel, ticket.expirationParams())
-	r.sm.QueueTicket(ticket.Sender, &SignedTicket{ticket, sig, recipientRand})
+	r.sm.QueueTicket(ticket.Sender, &SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	return nil
 }
 
@@ -212,6 +320,14 @@ func (r *recipient) TicketParams(sender ethcommon.Address, price *big.Rat) (*Tic
 
 	seed := new(big.Int).SetBytes(randBytes)
 
+	if err := r.checkSenderLists(sender); err != nil {
+		return nil, err
+	}
+
+	if err := r.checkSenderReserve(sender); err != nil {
+		return nil, err
+	}
+
 	faceValue, err := r.faceValue(sender)
 	if err != nil {
 		return nil, err
@@ -242,6 +358,62 @@ func (r *recipient) TicketParams(sender ethcommon.Address, price *big.Rat) (*Tic
 	}, nil
 }
 
+// SetSenderLists replaces the senders that the recipient accepts tickets from
+// If allowlist is empty, tickets are accepted from all senders that are not in denylist
+func (r *recipient) SetSenderLists(allowlist, denylist []ethcommon.Address) {
+	allowed := make(map[ethcommon.Address]bool)
+	for _, sender := range allowlist {
+		allowed[sender] = true
+	}
+
+	denied := make(map[ethcommon.Address]bool)
+	for _, sender := range denylist {
+		denied[sender] = true
+	}
+
+	r.senderListsLock.Lock()
+	defer r.senderListsLock.Unlock()
+
+	r.senderAllowlist = allowed
+	r.senderDenylist = denied
+}
+
+// checkSenderLists returns errSenderDenied if the sender is on the denylist
+// and errSenderNotAllowed if there is an allowlist that the sender is not on
+func (r *recipient) checkSenderLists(sender ethcommon.Address) error {
+	r.senderListsLock.RLock()
+	defer r.senderListsLock.RUnlock()
+
+	if r.senderDenylist[sender] {
+		return errSenderDenied
+	}
+
+	if len(r.senderAllowlist) > 0 && !r.senderAllowlist[sender] {
+		return errSenderNotAllowed
+	}
+
+	return nil
+}
+
+// checkSenderReserve returns errSenderReserveBelowMinimum if the sender's max float is below MinSenderReserve
+func (r *recipient) checkSenderReserve(sender ethcommon.Address) error {
+	if r.cfg.MinSenderReserve == nil {
+		return nil
+	}
+
+	// Max float is read through the sender monitor which caches the sender's reserve
+	maxFloat, err := r.sm.MaxFloat(sender)
+	if err != nil {
+		return err
+	}
+
+	if maxFloat.Cmp(r.cfg.MinSenderReserve) < 0 {
+		return errSenderReserveBelowMinimum
+	}
+
+	return nil
+}
+
 func (r *recipient) txCost() *big.Int {
 	// Fetch current gasprice from cache through gasPrice monitor
 	gasPrice := r.gpm.GasPrice()
@@ -253,6 +425,12 @@ func (r *recipient) faceValue(sender ethcommon.Address) (*big.Int, error) {
 	// faceValue = txCost * txCostMultiplier
 	faceValue := new(big.Int).Mul(r.txCost(), big.NewInt(int64(r.cfg.TxCostMultiplier)))
 
+	// If faceValue > MaxFaceValue
+	// Set faceValue = MaxFaceValue
+	if r.cfg.MaxFaceValue != nil && faceValue.Cmp(r.cfg.MaxFaceValue) > 0 {
+		faceValue = r.cfg.MaxFaceValue
+	}
+
 	// TODO: Consider setting faceValue to some value higher than
 	// EV in this case where the default faceValue < the desired EV.
 	// At the moment, for simplicity we just adjust faceValue to the
@@ -317,7 +495,27 @@ func (r *recipient) TxCostMultiplier(sender ethcommon.Address) (*big.Rat, error)
 	return new(big.Rat).SetFrac(faceValue, r.txCost()), nil
 }
 
-func (r *recipient) redeemWinningTicket(ticket *Ticket, sig []byte, recipientRand *big.Int) error {
+func (r *recipient) redeemWinningTicket(ticket *SignedTicket) error {
+	// Chain state might have changed since the ticket was queued so check that
+	// the ticket is still redeemable to avoid submitting a reverting transaction
+	if r.cfg.RevalidateQueuedTickets {
+		err := r.revalidateTicket(ticket.Ticket)
+		if err == errTicketExpired || err == errTicketUsed {
+			r.sm.AckTicket(ticket.Sender, ticket)
+
+			if monitor.Enabled {
+				monitor.TicketRedemptionDropped(ticket.Sender.String())
+			}
+
+			return errors.Wrap(err, "dropping ticket")
+		}
+		// If the chain state could not be checked, queue the ticket to be retried later
+		if err != nil {
+			r.sm.QueueTicket(ticket.Sender, ticket)
+			return err
+		}
+	}
+
 	maxFloat, err := r.sm.MaxFloat(ticket.Sender)
 	if err != nil {
 		return err
@@ -325,17 +523,48 @@ func (r *recipient) redeemWinningTicket(ticket *Ticket, sig []byte, recipientRan
 
 	// if max float is zero, there is no claimable reserve left or reserve is 0
 	if maxFloat.Cmp(big.NewInt(0)) == 0 {
-		r.sm.QueueTicket(ticket.Sender, &SignedTicket{ticket, sig, recipientRand})
+		r.sm.QueueTicket(ticket.Sender, ticket)
 		return errors.Errorf("max float is zero")
 	}
 
 	// If max float is insufficient to cover the ticket face value, queue
 	// the ticket to be retried later
 	if maxFloat.Cmp(ticket.FaceValue) < 0 {
-		r.sm.QueueTicket(ticket.Sender, &SignedTicket{ticket, sig, recipientRand})
+		r.sm.QueueTicket(ticket.Sender, ticket)
 		return fmt.Errorf("insufficient max float - faceValue=%v maxFloat=%v", ticket.FaceValue, maxFloat)
 	}
 
+	// If the gas price is too high, queue the ticket to be retried later
+	// when the gas price is lower
+	if r.cfg.MaxRedemptionGasPrice != nil {
+		gasPrice := r.gpm.GasPrice()
+		if gasPrice != nil && gasPrice.Cmp(r.cfg.MaxRedemptionGasPrice) > 0 {
+			r.sm.QueueTicket(ticket.Sender, ticket)
+
+			if monitor.Enabled {
+				monitor.TicketRedemptionDeferred(ticket.Sender.String())
+			}
+
+			return fmt.Errorf("gas price too high - gasPrice=%v maxGasPrice=%v", gasPrice, r.cfg.MaxRedemptionGasPrice)
+		}
+	}
+
+	// If ticket redemption is paused after consecutive failures, queue
+	// the ticket to be retried later instead of submitting a transaction
+	// that is likely to fail
+	if !r.breaker.Allow() {
+		r.sm.QueueTicket(ticket.Sender, ticket)
+		return errRedemptionCircuitOpen
+	}
+
+	// In shadow mode, record the redemption that would have been submitted
+	// instead of submitting a transaction
+	if r.cfg.ShadowMode {
+		r.sm.AckTicket(ticket.Sender, ticket)
+		r.shadowRedemption(ticket.Ticket)
+		return nil
+	}
+
 	// Subtract the ticket face value from the sender's current max float
 	// This amount will be considered pending until the ticket redemption
 	// transaction confirms on-chain
@@ -358,8 +587,11 @@ func (r *recipient) redeemWinningTicket(ticket *Ticket, sig []byte, recipientRan
 
 	// Assume that that this call will return immediately if there
 	// is an error in transaction submission
-	tx, err := r.broker.RedeemWinningTicket(ticket, sig, recipientRand)
+	tx, err := r.broker.RedeemWinningTicket(ticket.Ticket, ticket.Sig, ticket.RecipientRand)
 	if err != nil {
+		r.breaker.Failure()
+		r.retryRedemption(ticket, err)
+
 		if monitor.Enabled {
 			monitor.TicketRedemptionError(ticket.Sender.String())
 		}
@@ -367,16 +599,27 @@ func (r *recipient) redeemWinningTicket(ticket *Ticket, sig []byte, recipientRan
 		return err
 	}
 
+	// If there is no error, the transaction has been submitted so the ticket
+	// will not be queued again
+	r.sm.AckTicket(ticket.Sender, ticket)
+
 	// If there is no error, the transaction has been submitted. As a result,
 	// we assume that recipientRand has been revealed so we should invalidate it locally
-	r.updateInvalidRands(recipientRand)
+	r.updateInvalidRands(ticket.RecipientRand)
 
 	// After we invalidate recipientRand we can clear the memory used to track
 	// its latest senderNonce
-	r.clearSenderNonce(recipientRand)
+	r.clearSenderNonce(ticket.RecipientRand)
 
 	// Wait for transaction to confirm
 	if err := r.broker.CheckTx(tx); err != nil {
+		r.breaker.Failure()
+
+		// The redemption is not retried because recipientRand was revealed when the
+		// transaction was submitted
+		ticket.redemptionAttempts++
+		r.deadLetter(ticket, err)
+
 		if monitor.Enabled {
 			monitor.TicketRedemptionError(ticket.Sender.String())
 		}
@@ -384,6 +627,8 @@ func (r *recipient) redeemWinningTicket(ticket *Ticket, sig []byte, recipientRan
 		return err
 	}
 
+	r.breaker.Success()
+
 	if monitor.Enabled {
 		// TODO(yondonfu): Handle case where < ticket.FaceValue is actually
 		// redeemed i.e. if sender reserve cannot cover the full ticket.FaceValue
@@ -393,6 +638,119 @@ func (r *recipient) redeemWinningTicket(ticket *Ticket, sig []byte, recipientRan
 	return nil
 }
 
+// revalidateTicket checks if a ticket is still redeemable given the current chain state
+// Returns errTicketExpired or errTicketUsed if the ticket is no longer redeemable
+func (r *recipient) revalidateTicket(ticket *Ticket) error {
+	// Tickets without expiration params cannot be checked for expiration
+	if ticket.CreationRound > 0 {
+		validityPeriod, err := r.ticketValidityPeriod()
+		if err != nil {
+			return err
+		}
+
+		// If the validity period is unknown the ticket cannot be checked for expiration
+		if validityPeriod != nil {
+			expirationRound := new(big.Int).Add(big.NewInt(ticket.CreationRound), validityPeriod)
+			if expirationRound.Cmp(r.tm.LastInitializedRound()) <= 0 {
+				return errTicketExpired
+			}
+		}
+	}
+
+	used, err := r.broker.IsUsedTicket(ticket)
+	if err != nil {
+		return err
+	}
+	if used {
+		return errTicketUsed
+	}
+
+	return nil
+}
+
+// ticketValidityPeriod returns the TicketBroker ticket validity period
+// The ticket validity period is a TicketBroker parameter that can be updated by governance so it is
+// read from the broker instead of being assumed, but at most once per round
+func (r *recipient) ticketValidityPeriod() (*big.Int, error) {
+	r.validityPeriodLock.Lock()
+	defer r.validityPeriodLock.Unlock()
+
+	round := r.tm.LastInitializedRound()
+	if r.validityPeriod != nil && r.validityPeriodRound.Cmp(round) == 0 {
+		return r.validityPeriod, nil
+	}
+
+	validityPeriod, err := r.broker.TicketValidityPeriod()
+	if err != nil {
+		return nil, err
+	}
+
+	// Do not cache an unknown validity period so that it is read again for the next ticket
+	if validityPeriod == nil {
+		return nil, nil
+	}
+
+	r.validityPeriod = validityPeriod
+	r.validityPeriodRound = round
+
+	return validityPeriod, nil
+}
+
+// shadowRedemption records a winning ticket that would have been redeemed if shadow mode was not enabled
+func (r *recipient) shadowRedemption(ticket *Ticket) {
+	glog.Infof("Shadow mode: would redeem ticket sender=%x recipientRandHash=%x senderNonce=%v faceValue=%v", ticket.Sender, ticket.RecipientRandHash, ticket.SenderNonce, ticket.FaceValue)
+
+	recordShadowRedemption(ticket.Sender, ticket.FaceValue)
+}
+
+// retryRedemption queues a ticket that failed to be submitted for redemption to be retried after
+// a backoff period. After MaxRedemptionAttempts failed attempts the ticket is dead-lettered
+func (r *recipient) retryRedemption(ticket *SignedTicket, redeemErr error) {
+	ticket.redemptionAttempts++
+	if ticket.redemptionAttempts >= r.cfg.MaxRedemptionAttempts {
+		r.sm.AckTicket(ticket.Sender, ticket)
+		r.deadLetter(ticket, redeemErr)
+		return
+	}
+
+	// backoff = RedemptionRetryBackoff * 2^(redemptionAttempts - 1)
+	backoff := new(big.Int).Lsh(big.NewInt(int64(r.cfg.RedemptionRetryBackoff)), uint(ticket.redemptionAttempts-1))
+	ticket.nextRedemptionBlock = new(big.Int).Add(r.tm.LastSeenBlock(), backoff)
+
+	r.sm.QueueTicket(ticket.Sender, ticket)
+}
+
+// deadLetter records a ticket that could not be redeemed with the reason for the last failed redemption attempt
+func (r *recipient) deadLetter(ticket *SignedTicket, reason error) {
+	glog.Errorf("Dropping ticket after failed redemption attempts=%v sender=%x recipientRandHash=%x senderNonce=%v err=%v", ticket.redemptionAttempts, ticket.Sender, ticket.RecipientRandHash, ticket.SenderNonce, reason)
+
+	if r.cfg.DeadLetterSink != nil {
+		if err := r.cfg.DeadLetterSink.StoreDeadLetter(ticket, reason); err != nil {
+			glog.Errorf("error storing dead-lettered ticket sender=%x recipientRandHash=%x senderNonce=%v err=%v", ticket.Sender, ticket.RecipientRandHash, ticket.SenderNonce, err)
+		}
+	}
+
+	if monitor.Enabled {
+		monitor.TicketRedemptionDeadLetter(ticket.Sender.String())
+	}
+}
+
+// redemptionCircuitStateChanged reports a change in the state of the ticket redemption circuit breaker
+func (r *recipient) redemptionCircuitStateChanged(state CircuitState) {
+	switch state {
+	case CircuitOpen:
+		glog.Errorf("Pausing ticket redemption for %v after consecutive redemption failures", r.cfg.RedemptionCooldown)
+	case CircuitHalfOpen:
+		glog.Infof("Resuming ticket redemption with a trial redemption after pausing for %v", r.cfg.RedemptionCooldown)
+	case CircuitClosed:
+		glog.Infof("Resumed ticket redemption")
+	}
+
+	if monitor.Enabled {
+		monitor.TicketRedemptionCircuitState(int(state))
+	}
+}
+
 func (r *recipient) rand(seed *big.Int, sender ethcommon.Address, faceValue *big.Int, winProb *big.Int, expirationBlock *big.Int, price *big.Rat, ticketExpirationParams *TicketExpirationParams) *big.Int {
 	h := hmac.New(sha256.New, r.secret[:])
 	msg := append(seed.Bytes(), sender.Bytes()...)
@@ -443,7 +801,11 @@ func (r *recipient) redeemManager() {
 	for {
 		select {
 		case ticket := <-r.sm.Redeemable():
-			if err := r.redeemWinningTicket(ticket.Ticket, ticket.Sig, ticket.RecipientRand); err != nil {
+			err := r.redeemWinningTicket(ticket)
+			if err == errRedemptionCircuitOpen {
+				// The circuit opening is already logged so only log each ticket that remains queued at a higher verbosity
+				glog.V(5).Infof("ticket redemption paused - sender=%x recipientRandHash=%x senderNonce=%v", ticket.Sender, ticket.RecipientRandHash, ticket.SenderNonce)
+			} else if err != nil {
 				glog.Errorf("error redeeming ticket - sender=%x recipientRandHash=%x senderNonce=%v err=%v", ticket.Sender, ticket.RecipientRandHash, ticket.SenderNonce, err)
 			}
 		case <-r.quit:
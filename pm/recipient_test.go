@@ -202,6 +202,79 @@ func TestReceiveTicket_InvalidSender(t *testing.T) {
 	assert.EqualError(err, "Invalid Sender")
 }
 
+func TestReceiveTicket_MinSenderReserve(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	cfg.MinSenderReserve = big.NewInt(1000000000)
+	r := newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	// Test sender above the minimum reserve
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 1), sig, params.Seed)
+	assert.Nil(err)
+
+	// Test sender at the minimum reserve
+	sm.maxFloat = big.NewInt(1000000000)
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 2), sig, params.Seed)
+	assert.Nil(err)
+
+	// Test sender below the minimum reserve
+	sm.maxFloat = big.NewInt(999999999)
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 3), sig, params.Seed)
+	assert.EqualError(err, errSenderReserveBelowMinimum.Error())
+	_, ok := err.(*FatalReceiveErr)
+	assert.True(ok)
+
+	// Test MaxFloat() error
+	sm.maxFloatErr = errors.New("MaxFloat error")
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 4), sig, params.Seed)
+	assert.EqualError(err, sm.maxFloatErr.Error())
+}
+
+func TestReceiveTicket_SenderLists(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	r := newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	// Test sender on the allowlist
+	r.SetSenderLists([]ethcommon.Address{sender}, nil)
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 1), sig, params.Seed)
+	assert.Nil(err)
+
+	// Test sender not on the allowlist
+	r.SetSenderLists([]ethcommon.Address{RandAddress()}, nil)
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 2), sig, params.Seed)
+	assert.EqualError(err, errSenderNotAllowed.Error())
+	_, ok := err.(*FatalReceiveErr)
+	assert.True(ok)
+
+	// Test sender on the denylist
+	r.SetSenderLists(nil, []ethcommon.Address{sender})
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 3), sig, params.Seed)
+	assert.EqualError(err, errSenderDenied.Error())
+	_, ok = err.(*FatalReceiveErr)
+	assert.True(ok)
+
+	// Test sender on both lists is denied
+	r.SetSenderLists([]ethcommon.Address{sender}, []ethcommon.Address{sender})
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 4), sig, params.Seed)
+	assert.EqualError(err, errSenderDenied.Error())
+
+	// Test clearing the lists accepts the sender again
+	r.SetSenderLists(nil, nil)
+	_, _, err = r.ReceiveTicket(newTicket(sender, params, 5), sig, params.Seed)
+	assert.Nil(err)
+}
+
 func TestReceiveTicket_ValidNonWinningTicket(t *testing.T) {
 	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
 	secret := [32]byte{3}
@@ -522,10 +595,10 @@ func TestRedeemWinningTicket_SingleTicket_ZeroMaxFloat(t *testing.T) {
 	err = r.RedeemWinningTicket(ticket, sig, params.Seed)
 	assert.Nil(err)
 	assert.Equal(1, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket, sig, recipientRand}, sm.queued[0])
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
 
 	sm.maxFloat = big.NewInt(0)
-	err = r.redeemWinningTicket(ticket, sig, recipientRand)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	assert.EqualError(err, "max float is zero")
 }
 
@@ -558,11 +631,11 @@ func TestRedeemWinningTicket_SingleTicket_RedeemError(t *testing.T) {
 	err = r.RedeemWinningTicket(ticket, sig, params.Seed)
 	assert.Nil(err)
 	assert.Equal(1, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket, sig, recipientRand}, sm.queued[0])
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
 
 	// Config stub broker to fail redeem
 	b.redeemShouldFail = true
-	err = r.redeemWinningTicket(ticket, sig, recipientRand)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	assert.EqualError(err, "stub broker redeem error")
 
 	used, err := b.IsUsedTicket(ticket)
@@ -574,6 +647,395 @@ func TestRedeemWinningTicket_SingleTicket_RedeemError(t *testing.T) {
 	assert.Contains(r.senderNonces, recipientRand.String())
 }
 
+func TestRedeemWinningTicket_SingleTicket_RedemptionCircuitOpen(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	setTime(0)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	cfg.RedemptionFailureThreshold = 2
+	cfg.RedemptionCooldown = time.Minute
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	v.SetIsWinningTicket(true)
+
+	ticket := newTicket(sender, params, 2)
+	recipientRand := genRecipientRand(sender, secret, params)
+
+	// Config stub broker to fail redeem
+	b.redeemShouldFail = true
+	for i := 0; i < 2; i++ {
+		err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+		assert.EqualError(err, "stub broker redeem error")
+	}
+	assert.Equal(0, len(sm.queued))
+
+	// Circuit is open so the ticket is queued without submitting a transaction
+	b.redeemShouldFail = false
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.Equal(errRedemptionCircuitOpen, err)
+	assert.Equal(1, len(sm.queued))
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
+
+	used, err := b.IsUsedTicket(ticket)
+	require.Nil(err)
+	assert.False(used)
+
+	// After the cooldown a trial redemption is submitted and closes the circuit on success
+	increaseTime(60)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.NoError(err)
+	assert.Equal(CircuitClosed, r.breaker.State())
+
+	used, err = b.IsUsedTicket(ticket)
+	require.Nil(err)
+	assert.True(used)
+}
+
+func TestRedeemWinningTicket_SingleTicket_RevalidateQueuedTickets(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	cfg.RevalidateQueuedTickets = true
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	ticket := newTicket(sender, params, 2)
+	recipientRand := genRecipientRand(sender, secret, params)
+
+	// Test ticket expired since it was queued
+	b.ticketValidityPeriod = big.NewInt(5)
+	tm.round = new(big.Int).Add(big.NewInt(ticket.CreationRound), big.NewInt(5))
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.Equal(errTicketExpired, errors.Cause(err))
+	assert.Equal(0, len(sm.queued))
+
+	used, err := b.IsUsedTicket(ticket)
+	require.Nil(err)
+	assert.False(used)
+
+	// Test error fetching the ticket validity period queues the ticket to be retried
+	// The round changes so that the cached validity period is not used
+	tm.round = new(big.Int).Add(tm.round, big.NewInt(1))
+	b.ticketValidityPeriodErr = errors.New("TicketValidityPeriod error")
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.EqualError(err, "TicketValidityPeriod error")
+	assert.Equal(1, len(sm.queued))
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
+	sm.queued = nil
+	b.ticketValidityPeriodErr = nil
+
+	// Test ticket redeemed since it was queued
+	tm.round = big.NewInt(ticket.CreationRound)
+	b.mu.Lock()
+	b.usedTickets[ticket.Hash()] = true
+	b.mu.Unlock()
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.Equal(errTicketUsed, errors.Cause(err))
+	assert.Equal(0, len(sm.queued))
+
+	_, ok := r.invalidRands.Load(recipientRand.String())
+	assert.False(ok)
+
+	// Test error checking chain state queues the ticket to be retried
+	b.isUsedTicketErr = errors.New("IsUsedTicket error")
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.EqualError(err, "IsUsedTicket error")
+	assert.Equal(1, len(sm.queued))
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
+
+	// Test valid ticket is redeemed
+	b.isUsedTicketErr = nil
+	b.mu.Lock()
+	delete(b.usedTickets, ticket.Hash())
+	b.mu.Unlock()
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.NoError(err)
+
+	used, err = b.IsUsedTicket(ticket)
+	require.Nil(err)
+	assert.True(used)
+}
+
+func TestRevalidateTicket_TicketValidityPeriod(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, _ := newRecipientFixtureOrFatal(t)
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, [32]byte{3}, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	ticket := newTicket(sender, params, 2)
+
+	// Test the validity period is read once per round
+	assert.Nil(r.revalidateTicket(ticket))
+	assert.Nil(r.revalidateTicket(ticket))
+	assert.Equal(1, b.ticketValidityPeriodCalls)
+
+	tm.round = new(big.Int).Add(tm.round, big.NewInt(1))
+	assert.Nil(r.revalidateTicket(ticket))
+	assert.Equal(2, b.ticketValidityPeriodCalls)
+
+	// Test the validity period is used to check for expiration
+	tm.round = new(big.Int).Add(big.NewInt(ticket.CreationRound), b.ticketValidityPeriod)
+	assert.Equal(errTicketExpired, r.revalidateTicket(ticket))
+	assert.Equal(3, b.ticketValidityPeriodCalls)
+
+	// Test an unknown validity period skips the expiration check and is not cached
+	tm.round = new(big.Int).Add(tm.round, big.NewInt(1))
+	b.ticketValidityPeriod = nil
+	assert.Nil(r.revalidateTicket(ticket))
+	assert.Nil(r.revalidateTicket(ticket))
+	assert.Equal(5, b.ticketValidityPeriodCalls)
+}
+
+func TestRedeemWinningTicket_SingleTicket_MaxRedemptionGasPrice(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	cfg.MaxRedemptionGasPrice = big.NewInt(100)
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	ticket := newTicket(sender, params, 2)
+	recipientRand := genRecipientRand(sender, secret, params)
+
+	// Test gas price above max defers redemption
+	gm.gasPrice = big.NewInt(101)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.EqualError(err, "gas price too high - gasPrice=101 maxGasPrice=100")
+	assert.Equal(1, len(sm.queued))
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
+
+	used, err := b.IsUsedTicket(ticket)
+	require.Nil(err)
+	assert.False(used)
+
+	// Test gas price at max redeems
+	gm.gasPrice = big.NewInt(100)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	assert.NoError(err)
+	assert.Equal(1, len(sm.queued))
+
+	used, err = b.IsUsedTicket(ticket)
+	require.Nil(err)
+	assert.True(used)
+}
+
+func TestRedeemWinningTicket_SingleTicket_RetryFailedRedemption(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	sink := &stubDeadLetterSink{}
+	cfg.MaxRedemptionAttempts = 3
+	cfg.RedemptionRetryBackoff = 2
+	cfg.DeadLetterSink = sink
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	ticket := newTicket(sender, params, 2)
+	recipientRand := genRecipientRand(sender, secret, params)
+	signedTicket := &SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}
+	tm.lastSeenBlock = big.NewInt(100)
+
+	// Test redemption fails twice and the ticket is queued to be retried with backoff
+	b.redeemShouldFail = true
+	err = r.redeemWinningTicket(signedTicket)
+	assert.EqualError(err, "stub broker redeem error")
+	require.Equal(1, len(sm.queued))
+	assert.Equal(signedTicket, sm.queued[0])
+	assert.Equal(1, sm.queued[0].redemptionAttempts)
+	assert.Equal(big.NewInt(102), sm.queued[0].nextRedemptionBlock)
+
+	err = r.redeemWinningTicket(sm.queued[0])
+	assert.EqualError(err, "stub broker redeem error")
+	require.Equal(2, len(sm.queued))
+	assert.Equal(2, sm.queued[1].redemptionAttempts)
+	assert.Equal(big.NewInt(104), sm.queued[1].nextRedemptionBlock)
+
+	// Test redemption succeeds on the third attempt
+	b.redeemShouldFail = false
+	err = r.redeemWinningTicket(sm.queued[1])
+	assert.NoError(err)
+	assert.Equal(2, len(sm.queued))
+	assert.Len(sink.tickets, 0)
+
+	used, err := b.IsUsedTicket(ticket)
+	require.Nil(err)
+	assert.True(used)
+}
+
+func TestRedeemWinningTicket_SingleTicket_RedemptionDeadLetter(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	sink := &stubDeadLetterSink{}
+	cfg.MaxRedemptionAttempts = 3
+	cfg.DeadLetterSink = sink
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	recipientRand := genRecipientRand(sender, secret, params)
+
+	// Test ticket that fails to be submitted on every attempt is dead-lettered after the last attempt
+	b.redeemShouldFail = true
+	signedTicket := &SignedTicket{Ticket: newTicket(sender, params, 2), Sig: sig, RecipientRand: recipientRand}
+	for i := 0; i < cfg.MaxRedemptionAttempts; i++ {
+		err = r.redeemWinningTicket(signedTicket)
+		assert.EqualError(err, "stub broker redeem error")
+	}
+	assert.Equal(cfg.MaxRedemptionAttempts-1, len(sm.queued))
+
+	require.Len(sink.tickets, 1)
+	assert.Equal(signedTicket, sink.tickets[0])
+	assert.EqualError(sink.reasons[0], "stub broker redeem error")
+	assert.Equal(cfg.MaxRedemptionAttempts, sink.tickets[0].redemptionAttempts)
+
+	// Test ticket with a failed redemption transaction is dead-lettered without being retried
+	sm.queued = nil
+	b.redeemShouldFail = false
+	b.checkTxErr = errors.New("CheckTx error")
+	signedTicket = &SignedTicket{Ticket: newTicket(sender, params, 3), Sig: sig, RecipientRand: recipientRand}
+	err = r.redeemWinningTicket(signedTicket)
+	assert.EqualError(err, "CheckTx error")
+	assert.Equal(0, len(sm.queued))
+
+	require.Len(sink.tickets, 2)
+	assert.Equal(signedTicket, sink.tickets[1])
+	assert.EqualError(sink.reasons[1], "CheckTx error")
+
+	// Test failed redemption is dead-lettered without being retried if retries are disabled
+	cfg.MaxRedemptionAttempts = 1
+	r = NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	b.checkTxErr = nil
+	b.redeemShouldFail = true
+	signedTicket = &SignedTicket{Ticket: newTicket(sender, params, 4), Sig: sig, RecipientRand: recipientRand}
+	err = r.redeemWinningTicket(signedTicket)
+	assert.EqualError(err, "stub broker redeem error")
+	assert.Equal(0, len(sm.queued))
+	require.Len(sink.tickets, 3)
+	assert.Equal(signedTicket, sink.tickets[2])
+
+	// Test failed redemption transaction is dead-lettered if retries are disabled
+	b.redeemShouldFail = false
+	b.checkTxErr = errors.New("CheckTx error")
+	signedTicket = &SignedTicket{Ticket: newTicket(sender, params, 5), Sig: sig, RecipientRand: recipientRand}
+	err = r.redeemWinningTicket(signedTicket)
+	assert.EqualError(err, "CheckTx error")
+	require.Len(sink.tickets, 4)
+	assert.Equal(signedTicket, sink.tickets[3])
+
+	// Test ticket is still dropped if it cannot be stored in the sink
+	sink.storeShouldFail = true
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: newTicket(sender, params, 6), Sig: sig, RecipientRand: recipientRand})
+	assert.EqualError(err, "CheckTx error")
+	assert.Equal(0, len(sm.queued))
+	assert.Len(sink.tickets, 4)
+}
+
+func TestRedeemWinningTicket_SingleTicket_ShadowMode(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	var shadowRedemptions int
+	shadowValue := big.NewInt(0)
+	oldRecordShadowRedemption := recordShadowRedemption
+	recordShadowRedemption = func(sender ethcommon.Address, faceValue *big.Int) {
+		shadowRedemptions++
+		shadowValue.Add(shadowValue, faceValue)
+	}
+	defer func() { recordShadowRedemption = oldRecordShadowRedemption }()
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	cfg.ShadowMode = true
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	ticket0 := newTicket(sender, params, 2)
+	ticket1 := newTicket(sender, params, 3)
+	recipientRand := genRecipientRand(sender, secret, params)
+
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket0, Sig: sig, RecipientRand: recipientRand})
+	assert.NoError(err)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket1, Sig: sig, RecipientRand: recipientRand})
+	assert.NoError(err)
+
+	// Test that no redemption was submitted
+	used, err := b.IsUsedTicket(ticket0)
+	require.Nil(err)
+	assert.False(used)
+	used, err = b.IsUsedTicket(ticket1)
+	require.Nil(err)
+	assert.False(used)
+	assert.True(r.validRand(recipientRand))
+	assert.Equal(0, len(sm.queued))
+
+	// Test that the redemptions that would have been submitted are recorded
+	assert.Equal(2, shadowRedemptions)
+	assert.Equal(new(big.Int).Add(ticket0.FaceValue, ticket1.FaceValue), shadowValue)
+}
+
+func TestRedeemWinningTicket_SingleTicket_AckTicket(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	cfg.RevalidateQueuedTickets = true
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg).(*recipient)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+
+	recipientRand := genRecipientRand(sender, secret, params)
+
+	// Test ticket queued again is not acknowledged
+	ticket := &SignedTicket{Ticket: newTicket(sender, params, 1), Sig: sig, RecipientRand: recipientRand}
+	maxFloat := sm.maxFloat
+	sm.maxFloat = big.NewInt(0)
+	err = r.redeemWinningTicket(ticket)
+	assert.EqualError(err, "max float is zero")
+	assert.Equal([]*SignedTicket{ticket}, sm.queued)
+	assert.Len(sm.acked, 0)
+	sm.maxFloat = maxFloat
+
+	// Test ticket that failed to be submitted and is not retried is acknowledged
+	b.redeemShouldFail = true
+	err = r.redeemWinningTicket(ticket)
+	assert.NotNil(err)
+	assert.Equal([]*SignedTicket{ticket}, sm.acked)
+	b.redeemShouldFail = false
+
+	// Test submitted ticket is acknowledged
+	sm.acked = nil
+	err = r.redeemWinningTicket(ticket)
+	assert.NoError(err)
+	assert.Equal([]*SignedTicket{ticket}, sm.acked)
+
+	// Test dropped ticket is acknowledged
+	sm.acked = nil
+	err = r.redeemWinningTicket(ticket)
+	assert.Equal(errTicketUsed, errors.Cause(err))
+	assert.Equal([]*SignedTicket{ticket}, sm.acked)
+}
+
 func TestRedeemWinningTicket_SingleTicket_CheckTxError(t *testing.T) {
 	require := require.New(t)
 	assert := assert.New(t)
@@ -600,9 +1062,9 @@ func TestRedeemWinningTicket_SingleTicket_CheckTxError(t *testing.T) {
 	err = r.RedeemWinningTicket(ticket, sig, params.Seed)
 	assert.Nil(err)
 	assert.Equal(1, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket, sig, recipientRand}, sm.queued[0])
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
 
-	err = r.redeemWinningTicket(ticket, sig, recipientRand)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	assert.EqualError(err, b.checkTxErr.Error())
 }
 
@@ -628,9 +1090,9 @@ func TestRedeemWinningTicket_SingleTicket(t *testing.T) {
 	err = r.RedeemWinningTicket(ticket, sig, params.Seed)
 	assert.Nil(err)
 	assert.Equal(1, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket, sig, recipientRand}, sm.queued[0])
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
 
-	err = r.redeemWinningTicket(ticket, sig, recipientRand)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	assert.NoError(err)
 
 	used, err := b.IsUsedTicket(ticket)
@@ -677,13 +1139,13 @@ func TestRedeemWinningTickets_MultipleTickets(t *testing.T) {
 	err = r.RedeemWinningTickets([]string{sessionID})
 	assert.NoError(err)
 	assert.Equal(2, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket0, sig, recipientRand}, sm.queued[0])
-	assert.Equal(&SignedTicket{ticket1, sig, recipientRand}, sm.queued[1])
+	assert.Equal(&SignedTicket{Ticket: ticket0, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
+	assert.Equal(&SignedTicket{Ticket: ticket1, Sig: sig, RecipientRand: recipientRand}, sm.queued[1])
 
 	// Actually redeem the tickets
-	err = r.redeemWinningTicket(ticket0, sig, recipientRand)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket0, Sig: sig, RecipientRand: recipientRand})
 	assert.NoError(err)
-	err = r.redeemWinningTicket(ticket1, sig, recipientRand)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket1, Sig: sig, RecipientRand: recipientRand})
 	assert.NoError(err)
 
 	used, err := b.IsUsedTicket(ticket0)
@@ -734,12 +1196,12 @@ func TestRedeemWinningTickets_MultipleTicketsFromMultipleSessions(t *testing.T)
 	err = r.RedeemWinningTickets([]string{sessionID0, sessionID1})
 	assert.NoError(err)
 	assert.Equal(2, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket0, sig, recipientRand0}, sm.queued[0])
-	assert.Equal(&SignedTicket{ticket1, sig, recipientRand1}, sm.queued[1])
+	assert.Equal(&SignedTicket{Ticket: ticket0, Sig: sig, RecipientRand: recipientRand0}, sm.queued[0])
+	assert.Equal(&SignedTicket{Ticket: ticket1, Sig: sig, RecipientRand: recipientRand1}, sm.queued[1])
 
-	err = r.redeemWinningTicket(ticket0, sig, recipientRand0)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket0, Sig: sig, RecipientRand: recipientRand0})
 	assert.NoError(err)
-	err = r.redeemWinningTicket(ticket1, sig, recipientRand1)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket1, Sig: sig, RecipientRand: recipientRand1})
 	assert.NoError(err)
 
 	used, err := b.IsUsedTicket(ticket0)
@@ -774,8 +1236,8 @@ func TestRedeemWinningTicket_MaxFloatError(t *testing.T) {
 	err := r.RedeemWinningTicket(ticket, sig, params.Seed)
 	assert.Nil(err)
 	assert.Equal(1, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket, sig, recipientRand}, sm.queued[0])
-	err = r.redeemWinningTicket(ticket, sig, recipientRand)
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	assert.EqualError(err, sm.maxFloatErr.Error())
 }
 
@@ -791,11 +1253,11 @@ func TestRedeemWinningTicket_InsufficientMaxFloat_QueueTicket(t *testing.T) {
 	ticket.FaceValue = big.NewInt(99999999999999)
 
 	recipientRand := genRecipientRand(sender, secret, params)
-	err := r.redeemWinningTicket(ticket, sig, recipientRand)
+	err := r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	assert.EqualError(err, "insufficient max float - faceValue=99999999999999 maxFloat=10000000000")
 
 	assert.Equal(1, len(sm.queued))
-	assert.Equal(&SignedTicket{ticket, sig, recipientRand}, sm.queued[0])
+	assert.Equal(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}, sm.queued[0])
 }
 
 func TestRedeemWinningTicket_AddFloatError(t *testing.T) {
@@ -816,7 +1278,7 @@ func TestRedeemWinningTicket_AddFloatError(t *testing.T) {
 
 	errorLogsBefore := glog.Stats.Error.Lines()
 	recipientRand := genRecipientRand(sender, secret, params)
-	r.redeemWinningTicket(ticket, sig, recipientRand)
+	r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	errorLogsAfter := glog.Stats.Error.Lines()
 	assert.Equal(int64(1), errorLogsAfter-errorLogsBefore)
 	used, err := b.IsUsedTicket(ticket)
@@ -847,7 +1309,7 @@ func TestRedeemWinningTicket(t *testing.T) {
 	errorLogsBefore := glog.Stats.Error.Lines()
 
 	recipientRand := genRecipientRand(sender, secret, params)
-	err = r.redeemWinningTicket(ticket, sig, recipientRand)
+	err = r.redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
 	assert.Nil(err)
 
 	errorLogsAfter := glog.Stats.Error.Lines()
@@ -886,7 +1348,7 @@ func TestRedeemManager_Error(t *testing.T) {
 	errorLogsBefore := glog.Stats.Error.Lines()
 
 	sm.maxFloatErr = errors.New("MaxFloat error")
-	sm.redeemable <- &SignedTicket{ticket, sig, recipientRand}
+	sm.redeemable <- &SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}
 
 	time.Sleep(time.Millisecond * 20)
 	errorLogsAfter := glog.Stats.Error.Lines()
@@ -905,6 +1367,41 @@ func TestRedeemManager_Error(t *testing.T) {
 	assert.True(ok)
 }
 
+func TestRedeemManager_RedemptionCircuitOpen(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, sig := newRecipientFixtureOrFatal(t)
+	cfg.RedemptionFailureThreshold = 1
+	cfg.RedemptionCooldown = time.Hour
+	secret := [32]byte{3}
+	r := NewRecipientWithSecret(RandAddress(), b, v, ts, gm, sm, tm, secret, cfg)
+
+	params := ticketParamsOrFatal(t, r, sender)
+	ticket := newTicket(sender, params, 1)
+	recipientRand := genRecipientRand(sender, secret, params)
+
+	// Open the circuit with a failed redemption
+	b.redeemShouldFail = true
+	err := r.(*recipient).redeemWinningTicket(&SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand})
+	require.NotNil(err)
+	require.Equal(CircuitOpen, r.(*recipient).breaker.State())
+
+	r.Start()
+	defer r.Stop()
+
+	errorLogsBefore := glog.Stats.Error.Lines()
+
+	sm.redeemable <- &SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}
+
+	time.Sleep(time.Millisecond * 20)
+	errorLogsAfter := glog.Stats.Error.Lines()
+
+	// Check that no error was logged for a ticket that remains queued while the circuit is open
+	assert.Equal(int64(0), errorLogsAfter-errorLogsBefore)
+	assert.Equal(1, len(sm.queued))
+}
+
 func TestRedeemManager(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -924,7 +1421,7 @@ func TestRedeemManager(t *testing.T) {
 
 	errorLogsBefore := glog.Stats.Error.Lines()
 
-	sm.redeemable <- &SignedTicket{ticket, sig, recipientRand}
+	sm.redeemable <- &SignedTicket{Ticket: ticket, Sig: sig, RecipientRand: recipientRand}
 
 	time.Sleep(time.Millisecond * 20)
 	errorLogsAfter := glog.Stats.Error.Lines()
@@ -1076,6 +1573,99 @@ func TestTicketParams(t *testing.T) {
 	assert.EqualError(err, errInsufficientSenderReserve.Error())
 }
 
+func TestTicketParams_MinSenderReserve(t *testing.T) {
+	assert := assert.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, _ := newRecipientFixtureOrFatal(t)
+	cfg.MinSenderReserve = big.NewInt(1000000000)
+	r := newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+
+	// Test sender above the minimum reserve
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	assert.Nil(err)
+	assert.NotNil(params)
+
+	// Test sender at the minimum reserve
+	sm.maxFloat = big.NewInt(1000000000)
+	params, err = r.TicketParams(sender, big.NewRat(1, 1))
+	assert.Nil(err)
+	assert.NotNil(params)
+
+	// Test sender below the minimum reserve
+	sm.maxFloat = big.NewInt(999999999)
+	params, err = r.TicketParams(sender, big.NewRat(1, 1))
+	assert.EqualError(err, errSenderReserveBelowMinimum.Error())
+	assert.Nil(params)
+}
+
+func TestTicketParams_MaxFaceValue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, _ := newRecipientFixtureOrFatal(t)
+	// The default faceValue = txCost * txCostMultiplier = 100 * 10000 * 100
+	faceValue := big.NewInt(100000000)
+
+	// Test default faceValue below MaxFaceValue
+	cfg.MaxFaceValue = big.NewInt(200000000)
+	r := newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+	assert.Equal(faceValue, params.FaceValue)
+
+	// Test default faceValue at MaxFaceValue
+	cfg.MaxFaceValue = big.NewInt(100000000)
+	r = newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+	params, err = r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+	assert.Equal(faceValue, params.FaceValue)
+
+	// Test default faceValue above MaxFaceValue
+	cfg.MaxFaceValue = big.NewInt(50000000)
+	r = newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+	params, err = r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+	assert.Equal(cfg.MaxFaceValue, params.FaceValue)
+	assert.Equal(r.(*recipient).winProb(cfg.MaxFaceValue), params.WinProb)
+
+	// Test maxFloat below MaxFaceValue
+	sm.maxFloat = big.NewInt(10000000)
+	params, err = r.TicketParams(sender, big.NewRat(1, 1))
+	require.Nil(err)
+	assert.Equal(sm.maxFloat, params.FaceValue)
+}
+
+func TestTicketParams_SenderLists(t *testing.T) {
+	assert := assert.New(t)
+
+	sender, b, v, ts, gm, sm, tm, cfg, _ := newRecipientFixtureOrFatal(t)
+
+	// Test sender on the allowlist
+	cfg.SenderAllowlist = []ethcommon.Address{sender}
+	r := newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+	params, err := r.TicketParams(sender, big.NewRat(1, 1))
+	assert.Nil(err)
+	assert.NotNil(params)
+
+	// Test sender not on the allowlist
+	params, err = r.TicketParams(RandAddress(), big.NewRat(1, 1))
+	assert.EqualError(err, errSenderNotAllowed.Error())
+	assert.Nil(params)
+
+	// Test sender on the denylist
+	cfg.SenderAllowlist = nil
+	cfg.SenderDenylist = []ethcommon.Address{sender}
+	r = newRecipientOrFatal(t, RandAddress(), b, v, ts, gm, sm, tm, cfg)
+	params, err = r.TicketParams(sender, big.NewRat(1, 1))
+	assert.EqualError(err, errSenderDenied.Error())
+	assert.Nil(params)
+
+	// Test sender not on the denylist
+	params, err = r.TicketParams(RandAddress(), big.NewRat(1, 1))
+	assert.Nil(err)
+	assert.NotNil(params)
+}
+
 func TestTxCostMultiplier_UsingFaceValue_ReturnsDefaultMultiplier(t *testing.T) {
 	sender, b, v, ts, gm, sm, tm, cfg, _ := newRecipientFixtureOrFatal(t)
 	recipient := RandAddress()
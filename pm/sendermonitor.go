@@ -31,6 +31,10 @@ type SenderMonitor interface {
 	// QueueTicket adds a ticket to the queue for a remote sender
 	QueueTicket(addr ethcommon.Address, ticket *SignedTicket)
 
+	// AckTicket acknowledges that a ticket received from Redeemable was submitted
+	// for redemption or dropped so that it is no longer held for the remote sender
+	AckTicket(addr ethcommon.Address, ticket *SignedTicket)
+
 	// AddFloat adds to a remote sender's max float
 	AddFloat(addr ethcommon.Address, amount *big.Int) error
 
@@ -68,6 +72,10 @@ type senderMonitor struct {
 	smgr   SenderManager
 	tm     TimeManager
 
+	// newQueue returns the TicketQueue used to store the
+	// queued tickets for a remote sender
+	newQueue func() TicketQueue
+
 	// redeemable is a channel that an external caller can use to
 	// receive tickets that are fed from the ticket queues for
 	// each of currently active remote senders
@@ -76,8 +84,15 @@ type senderMonitor struct {
 	quit chan struct{}
 }
 
-// NewSenderMonitor returns a new SenderMonitor
+// NewSenderMonitor returns a new SenderMonitor that stores queued tickets in memory
 func NewSenderMonitor(claimant ethcommon.Address, broker Broker, smgr SenderManager, tm TimeManager, cleanupInterval time.Duration, ttl int) SenderMonitor {
+	return NewSenderMonitorWithTicketQueue(claimant, broker, smgr, tm, cleanupInterval, ttl, NewMemTicketQueue)
+}
+
+// NewSenderMonitorWithTicketQueue returns a new SenderMonitor that stores the queued tickets for
+// each remote sender in a TicketQueue returned by newQueue. In most cases, NewSenderMonitor should
+// be used instead which will store queued tickets in memory
+func NewSenderMonitorWithTicketQueue(claimant ethcommon.Address, broker Broker, smgr SenderManager, tm TimeManager, cleanupInterval time.Duration, ttl int, newQueue func() TicketQueue) SenderMonitor {
 	return &senderMonitor{
 		claimant:        claimant,
 		cleanupInterval: cleanupInterval,
@@ -85,6 +100,7 @@ func NewSenderMonitor(claimant ethcommon.Address, broker Broker, smgr SenderMana
 		broker:          broker,
 		smgr:            smgr,
 		tm:              tm,
+		newQueue:        newQueue,
 		senders:         make(map[ethcommon.Address]*remoteSender),
 		redeemable:      make(chan *SignedTicket),
 		quit:            make(chan struct{}),
@@ -148,14 +164,16 @@ func (sm *senderMonitor) MaxFloat(addr ethcommon.Address) (*big.Int, error) {
 
 // QueueTicket adds a ticket to the queue for a remote sender
 func (sm *senderMonitor) QueueTicket(addr ethcommon.Address, ticket *SignedTicket) {
-	sm.mu.Lock()
-	defer sm.mu.Unlock()
-
-	sm.ensureCache(addr)
-
-	sm.senders[addr].queue.Add(ticket)
+	// The ticket is added outside of the lock because the queue might persist the ticket
+	sm.queue(addr).Add(ticket)
 	glog.Infof("Queued ticket sender=%v recipientRandHash=%v senderNonce=%v", ticket.Sender.Hex(), ticket.RecipientRandHash.Hex(), ticket.SenderNonce)
+}
 
+// AckTicket acknowledges that a ticket received from Redeemable was submitted
+// for redemption or dropped so that it is no longer held for the remote sender
+func (sm *senderMonitor) AckTicket(addr ethcommon.Address, ticket *SignedTicket) {
+	// The ticket is acknowledged outside of the lock because the queue might remove the persisted ticket
+	sm.queue(addr).Ack(ticket)
 }
 
 // ValidateSender checks whether a sender's unlock period ends the round after the next round
@@ -196,6 +214,16 @@ func (sm *senderMonitor) reserveAlloc(addr ethcommon.Address) (*big.Int, error)
 	return new(big.Int).Sub(new(big.Int).Div(reserve, poolSize), claimed), nil
 }
 
+// queue is a helper that returns the ticket queue for a remote sender
+func (sm *senderMonitor) queue(addr ethcommon.Address) *ticketQueue {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.ensureCache(addr)
+
+	return sm.senders[addr].queue
+}
+
 // ensureCache is a helper that checks if a remote sender is initialized
 // and if not will fetch and cache the remote sender's reserve alloc
 // Caller should hold the lock for senderMonitor
@@ -212,7 +240,7 @@ func (sm *senderMonitor) ensureCache(addr ethcommon.Address) {
 // Caller should hold the lock for senderMonitor unless the caller is
 // ensureCache() in which case the caller of ensureCache() should hold the lock
 func (sm *senderMonitor) cache(addr ethcommon.Address) {
-	queue := newTicketQueue(sm.tm.SubscribeBlocks)
+	queue := newTicketQueue(sm.newQueue(), sm.tm.SubscribeBlocks)
 	queue.Start()
 	done := make(chan struct{})
 	go sm.startTicketQueueConsumerLoop(queue, done)
@@ -246,6 +246,81 @@ func TestQueueTicketAndSignalNewBlock(t *testing.T) {
 	assert.Equal(uint32(3), tickets[1].Ticket.SenderNonce)
 }
 
+func TestAckTicket_PersistentTicketQueue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	claimant, b, smgr, tm := senderMonitorFixture()
+	store := &stubQueuedTicketStore{}
+	newQueue := func() TicketQueue { return NewPersistentTicketQueue(store) }
+
+	addr := RandAddress()
+	ticket := defaultSignedTicket(0)
+	ticket.Sender = addr
+
+	sm := NewSenderMonitorWithTicketQueue(claimant, b, smgr, tm, 5*time.Minute, 3600, newQueue)
+	sm.Start()
+	defer sm.Stop()
+
+	sm.QueueTicket(addr, ticket)
+
+	// Test the ticket is persisted until it is acknowledged
+	queue := sm.(*senderMonitor).senders[addr].queue
+	assert.Equal(ticket, queue.store.Dequeue())
+	persisted, err := store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal([]*SignedTicket{ticket}, persisted)
+
+	sm.AckTicket(addr, ticket)
+	persisted, err = store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Len(persisted, 0)
+}
+
+func TestQueueTicket_PersistentTicketQueue_Restart(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	claimant, b, smgr, tm := senderMonitorFixture()
+	store := &stubQueuedTicketStore{}
+	newQueue := func() TicketQueue { return NewPersistentTicketQueue(store) }
+
+	addr := RandAddress()
+	tickets := make([]*SignedTicket, 2)
+	for i := 0; i < 2; i++ {
+		tickets[i] = defaultSignedTicket(uint32(i))
+		tickets[i].Sender = addr
+		tickets[i].WinProb = big.NewInt(100)
+	}
+
+	sm := NewSenderMonitorWithTicketQueue(claimant, b, smgr, tm, 5*time.Minute, 3600, newQueue)
+	sm.Start()
+	for _, ticket := range tickets {
+		sm.QueueTicket(addr, ticket)
+	}
+	// Simulate a restart before the queued tickets are redeemed
+	sm.Stop()
+
+	sm = NewSenderMonitorWithTicketQueue(claimant, b, smgr, tm, 5*time.Minute, 3600, newQueue)
+	sm.Start()
+	defer sm.Stop()
+
+	err := RequeueStoredTickets(store, sm)
+	require.Nil(err)
+
+	queue := sm.(*senderMonitor).senders[addr].queue
+	assert.Equal(tickets, queue.store.Snapshot())
+
+	persisted, err := store.LoadQueuedTickets()
+	require.Nil(err)
+	assert.Equal(tickets, persisted)
+
+	// Test requeueing fails if persisted tickets cannot be loaded
+	store.loadShouldFail = true
+	err = RequeueStoredTickets(store, sm)
+	assert.EqualError(err, "stub queued ticket store load error")
+}
+
 func TestCleanup(t *testing.T) {
 	claimant, b, smgr, tm := senderMonitorFixture()
 	sm := NewSenderMonitor(claimant, b, smgr, tm, 5*time.Minute, 3600)
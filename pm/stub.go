@@ -85,6 +85,63 @@ func (ts *stubBlockStore) LastSeenBlock() (*big.Int, error) {
 	return ts.lastBlock, ts.err
 }
 
+type stubQueuedTicketStore struct {
+	tickets         []*SignedTicket
+	storeCalls      int
+	storeShouldFail bool
+	loadShouldFail  bool
+	lock            sync.Mutex
+}
+
+func (ts *stubQueuedTicketStore) StoreQueuedTicket(ticket *SignedTicket) error {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	ts.storeCalls++
+
+	if ts.storeShouldFail {
+		return fmt.Errorf("stub queued ticket store store error")
+	}
+
+	for _, t := range ts.tickets {
+		if t.Hash() == ticket.Hash() {
+			return nil
+		}
+	}
+
+	ts.tickets = append(ts.tickets, ticket)
+
+	return nil
+}
+
+func (ts *stubQueuedTicketStore) RemoveQueuedTicket(id ethcommon.Hash) error {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	for i, t := range ts.tickets {
+		if t.Hash() == id {
+			ts.tickets = append(ts.tickets[:i], ts.tickets[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (ts *stubQueuedTicketStore) LoadQueuedTickets() ([]*SignedTicket, error) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	if ts.loadShouldFail {
+		return nil, fmt.Errorf("stub queued ticket store load error")
+	}
+
+	tickets := make([]*SignedTicket, len(ts.tickets))
+	copy(tickets, ts.tickets)
+
+	return tickets, nil
+}
+
 type stubSigVerifier struct {
 	verifyResult bool
 }
@@ -97,6 +154,27 @@ func (sv *stubSigVerifier) Verify(addr ethcommon.Address, msg, sig []byte) bool
 	return sv.verifyResult
 }
 
+type stubDeadLetterSink struct {
+	tickets         []*SignedTicket
+	reasons         []error
+	storeShouldFail bool
+	lock            sync.Mutex
+}
+
+func (s *stubDeadLetterSink) StoreDeadLetter(ticket *SignedTicket, reason error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.storeShouldFail {
+		return fmt.Errorf("stub dead letter sink store error")
+	}
+
+	s.tickets = append(s.tickets, ticket)
+	s.reasons = append(s.reasons, reason)
+
+	return nil
+}
+
 type stubBroker struct {
 	deposits        map[ethcommon.Address]*big.Int
 	reserves        map[ethcommon.Address]*big.Int
@@ -108,13 +186,19 @@ type stubBroker struct {
 	getSenderInfoShouldFail    bool
 	claimableReserveShouldFail bool
 
-	checkTxErr error
+	checkTxErr      error
+	isUsedTicketErr error
+
+	ticketValidityPeriod      *big.Int
+	ticketValidityPeriodErr   error
+	ticketValidityPeriodCalls int
 }
 
 func newStubBroker() *stubBroker {
 	return &stubBroker{
-		usedTickets:     make(map[ethcommon.Hash]bool),
-		approvedSigners: make(map[ethcommon.Address]bool),
+		usedTickets:          make(map[ethcommon.Hash]bool),
+		approvedSigners:      make(map[ethcommon.Address]bool),
+		ticketValidityPeriod: big.NewInt(2),
 	}
 }
 
@@ -159,9 +243,23 @@ func (b *stubBroker) IsUsedTicket(ticket *Ticket) (bool, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if b.isUsedTicketErr != nil {
+		return false, b.isUsedTicketErr
+	}
+
 	return b.usedTickets[ticket.Hash()], nil
 }
 
+func (b *stubBroker) TicketValidityPeriod() (*big.Int, error) {
+	b.ticketValidityPeriodCalls++
+
+	if b.ticketValidityPeriodErr != nil {
+		return nil, b.ticketValidityPeriodErr
+	}
+
+	return b.ticketValidityPeriod, nil
+}
+
 func (b *stubBroker) ClaimableReserve(reserveHolder ethcommon.Address, claimant ethcommon.Address) (*big.Int, error) {
 	if b.claimableReserveShouldFail {
 		return nil, fmt.Errorf("stub broker ClaimableReserve error")
@@ -322,6 +420,7 @@ type stubSenderMonitor struct {
 	maxFloat          *big.Int
 	redeemable        chan *SignedTicket
 	queued            []*SignedTicket
+	acked             []*SignedTicket
 	acceptable        bool
 	addFloatErr       error
 	maxFloatErr       error
@@ -347,6 +446,10 @@ func (s *stubSenderMonitor) QueueTicket(addr ethcommon.Address, ticket *SignedTi
 	s.queued = append(s.queued, ticket)
 }
 
+func (s *stubSenderMonitor) AckTicket(addr ethcommon.Address, ticket *SignedTicket) {
+	s.acked = append(s.acked, ticket)
+}
+
 func (s *stubSenderMonitor) AddFloat(addr ethcommon.Address, amount *big.Int) error {
 	if s.addFloatErr != nil {
 		return s.addFloatErr
@@ -428,6 +531,11 @@ func (m *MockRecipient) EV() *big.Rat {
 	return args.Get(0).(*big.Rat)
 }
 
+// SetSenderLists replaces the senders that the recipient accepts tickets from
+func (m *MockRecipient) SetSenderLists(allowlist, denylist []ethcommon.Address) {
+	m.Called(allowlist, denylist)
+}
+
 // MockSender is useful for testing components that depend on pm.Sender
 type MockSender struct {
 	mock.Mock
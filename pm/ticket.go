@@ -27,6 +27,13 @@ type SignedTicket struct {
 	// RecipientRand is the recipient's random value that should be
 	// the preimage for the ticket's recipientRandHash
 	RecipientRand *big.Int
+
+	// redemptionAttempts is the number of failed attempts to redeem the ticket
+	redemptionAttempts int
+
+	// nextRedemptionBlock is the block number before which a failed redemption
+	// of the ticket should not be retried. If nil, the ticket can be redeemed immediately
+	nextRedemptionBlock *big.Int
 }
 
 // TicketParams represents the parameters defined by a receiver that a sender must adhere to when
@@ -2,6 +2,8 @@ package pm
 
 import (
 	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // TicketStore is an interface which describes an object capable
@@ -15,3 +17,40 @@ type TicketStore interface {
 	// for a session ID
 	LoadWinningTickets(sessionIDs []string) (tickets []*Ticket, sigs [][]byte, recipientRands []*big.Int, err error)
 }
+
+// QueuedTicketStore is an interface which describes an object capable
+// of persisting tickets that are queued for redemption
+type QueuedTicketStore interface {
+	// StoreQueuedTicket persists a queued ticket
+	// Storing a ticket that is already persisted is a no-op
+	StoreQueuedTicket(ticket *SignedTicket) error
+
+	// RemoveQueuedTicket removes the persisted queued ticket with the provided hash
+	RemoveQueuedTicket(id ethcommon.Hash) error
+
+	// LoadQueuedTickets fetches all persisted queued tickets ordered by the time they were first stored
+	LoadQueuedTickets() ([]*SignedTicket, error)
+}
+
+// DeadLetterSink is an interface which describes an object capable
+// of persisting winning tickets that could not be redeemed
+type DeadLetterSink interface {
+	// StoreDeadLetter persists a ticket that could not be redeemed with the reason
+	// for the last failed redemption attempt
+	StoreDeadLetter(ticket *SignedTicket, reason error) error
+}
+
+// RequeueStoredTickets queues all tickets persisted in a QueuedTicketStore with a SenderMonitor
+// This should be called on startup so that tickets queued before a restart are redeemed
+func RequeueStoredTickets(store QueuedTicketStore, sm SenderMonitor) error {
+	tickets, err := store.LoadQueuedTickets()
+	if err != nil {
+		return err
+	}
+
+	for _, ticket := range tickets {
+		sm.QueueTicket(ticket.Sender, ticket)
+	}
+
+	return nil
+}
@@ -293,6 +293,32 @@ func ticketBrokerParamsHandler(client eth.LivepeerEthClient) http.Handler {
 	})
 }
 
+func setSenderListsHandler(recipient pm.Recipient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if recipient == nil {
+			respondWith500(w, "missing ticket recipient")
+			return
+		}
+
+		allowlist, err := common.ParseAddresses(r.FormValue("allowlist"))
+		if err != nil {
+			respondWith400(w, fmt.Sprintf("invalid allowlist: %v", err))
+			return
+		}
+
+		denylist, err := common.ParseAddresses(r.FormValue("denylist"))
+		if err != nil {
+			respondWith400(w, fmt.Sprintf("invalid denylist: %v", err))
+			return
+		}
+
+		recipient.SetSenderLists(allowlist, denylist)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("setSenderLists success"))
+	})
+}
+
 func signMessageHandler(client eth.LivepeerEthClient) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if client == nil {
@@ -801,6 +801,61 @@ func TestVoteHandler(t *testing.T) {
 	assert.Equal([]byte("vote success"), body)
 }
 
+func TestSetSenderListsHandler_MissingRecipient(t *testing.T) {
+	handler := setSenderListsHandler(nil)
+
+	resp := httpPostFormResp(handler, nil)
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	assert := assert.New(t)
+	assert.Equal(http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal("missing ticket recipient", strings.TrimSpace(string(body)))
+}
+
+func TestSetSenderListsHandler_InvalidAddress(t *testing.T) {
+	assert := assert.New(t)
+	recipient := &pm.MockRecipient{}
+	handler := setSenderListsHandler(recipient)
+
+	form := url.Values{
+		"allowlist": {"foo"},
+	}
+	resp := httpPostFormResp(handler, strings.NewReader(form.Encode()))
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(strings.TrimSpace(string(body)), "invalid allowlist")
+
+	form = url.Values{
+		"denylist": {"foo"},
+	}
+	resp = httpPostFormResp(handler, strings.NewReader(form.Encode()))
+	body, _ = ioutil.ReadAll(resp.Body)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(strings.TrimSpace(string(body)), "invalid denylist")
+
+	recipient.AssertNotCalled(t, "SetSenderLists", mock.Anything, mock.Anything)
+}
+
+func TestSetSenderListsHandler_Success(t *testing.T) {
+	assert := assert.New(t)
+	recipient := &pm.MockRecipient{}
+	handler := setSenderListsHandler(recipient)
+
+	allowed := pm.RandAddress()
+	denied := pm.RandAddress()
+	recipient.On("SetSenderLists", []ethcommon.Address{allowed}, []ethcommon.Address{denied})
+
+	form := url.Values{
+		"allowlist": {allowed.Hex()},
+		"denylist":  {denied.Hex()},
+	}
+	resp := httpPostFormResp(handler, strings.NewReader(form.Encode()))
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("setSenderLists success", strings.TrimSpace(string(body)))
+	recipient.AssertExpectations(t)
+}
+
 func httpPostFormResp(handler http.Handler, body io.Reader) *http.Response {
 	headers := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded",
@@ -1144,6 +1144,7 @@ func (s *LivepeerServer) cliWebServerHandlers(bindAddr string) *http.ServeMux {
 	mux.Handle("/withdraw", withdrawHandler(s.LivepeerNode.Eth))
 	mux.Handle("/senderInfo", senderInfoHandler(s.LivepeerNode.Eth))
 	mux.Handle("/ticketBrokerParams", ticketBrokerParamsHandler(s.LivepeerNode.Eth))
+	mux.Handle("/setSenderLists", setSenderListsHandler(s.LivepeerNode.Recipient))
 
 	// Metrics
 	if monitor.Enabled {